@@ -3,6 +3,8 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 )
 
 type Config struct {
@@ -12,6 +14,30 @@ type Config struct {
 	DBPassword string;
 	DBName string;
 	ServerPort string;
+	GRPCPort string;
+	AppEnv string;
+	GraphQLMaxDepth int;
+	GraphQLMaxComplexity int;
+	DailyNoRepeatDays int;
+	CacheControlList string;
+	CacheControlDetail string;
+	ReadCacheSize int;
+	ReadCacheTTLSeconds int;
+	PublicBaseURL string;
+	TrustProxyHeaders bool;
+}
+
+// IsDevelopment reports whether dev-only tooling (like GraphiQL) should be served
+func (c *Config) IsDevelopment() bool {
+	return c.AppEnv == "development"
+}
+
+// ConnectionString builds the PostgreSQL connection string from the DB settings
+func (c *Config) ConnectionString() string {
+	return fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		c.DBHost, c.DBPort, c.DBUser, c.DBPassword, c.DBName,
+	)
 }
 
 // LoadConfig read from environment variables
@@ -25,6 +51,17 @@ func LoadConfig() (*Config, error) {
 		DBPassword: getEnv("DB_PASSWORD", "postgres"),
 		DBName: getEnv("DB_NAME", "pokemon_db"),
 		ServerPort: getEnv("SERVER_PORT", "8080"),
+		GRPCPort: getEnv("GRPC_PORT", "9090"),
+		AppEnv: getEnv("APP_ENV", "production"),
+		GraphQLMaxDepth: getEnvInt("GRAPHQL_MAX_DEPTH", 8),
+		GraphQLMaxComplexity: getEnvInt("GRAPHQL_MAX_COMPLEXITY", 5000),
+		DailyNoRepeatDays: getEnvInt("DAILY_NO_REPEAT_DAYS", 30),
+		CacheControlList: getEnv("CACHE_CONTROL_LIST", "public, max-age=60"),
+		CacheControlDetail: getEnv("CACHE_CONTROL_DETAIL", "public, max-age=300"),
+		ReadCacheSize: getEnvInt("READ_CACHE_SIZE", 1000),
+		ReadCacheTTLSeconds: getEnvInt("READ_CACHE_TTL_SECONDS", 300),
+		PublicBaseURL: strings.TrimSuffix(getEnv("PUBLIC_BASE_URL", ""), "/"),
+		TrustProxyHeaders: getEnv("TRUST_PROXY_HEADERS", "false") == "true",
 	}
 
 	if config.DBPassword == "postgres" {
@@ -41,4 +78,12 @@ func getEnv(key, defaultValue string) string {
 		return value
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+
+// Helper function to get an integer env variable with default value
+func getEnvInt(key string, defaultValue int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return value
+	}
+	return defaultValue
+}
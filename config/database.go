@@ -10,10 +10,7 @@ import (
 // ConnectDatabase establishes connection to PostgreSQL
 func ConnectDatabase(cfg *Config) (*sql.DB, error) {
 	// Build connection string
-	connStr := fmt.Sprintf(
-		"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
-		cfg.DBHost, cfg.DBPort, cfg.DBUser, cfg.DBPassword, cfg.DBName,
-	)
+	connStr := cfg.ConnectionString()
 
 	// Open connection
 	db, err := sql.Open("postgres", connStr)
@@ -32,6 +29,9 @@ func ConnectDatabase(cfg *Config) (*sql.DB, error) {
 // RunMigrations creates necessary database tables
 func RunMigrations(db *sql.DB) error {
 	migrations := []string{
+		// Trigram matching for fuzzy name search
+		`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+
 		// Pokemon table
 		`CREATE TABLE IF NOT EXISTS pokemon (
 			id SERIAL PRIMARY KEY,
@@ -85,6 +85,122 @@ func RunMigrations(db *sql.DB) error {
 			UNIQUE(pokemon_id)
 		)`,
 		
+		// Raw upstream payload table
+		`CREATE TABLE IF NOT EXISTS pokemon_raw (
+			id SERIAL PRIMARY KEY,
+			pokemon_id INT NOT NULL REFERENCES pokemon(id) ON DELETE CASCADE,
+			payload JSONB NOT NULL,
+			payload_hash VARCHAR(64) NOT NULL,
+			fetched_at TIMESTAMP NOT NULL,
+			UNIQUE(pokemon_id)
+		)`,
+		
+		// Databases that briefly stored payload as JSON go back to JSONB. Their payload_hash values were
+		// recomputed from re-serialised text and are only right again after the next sync.
+		`DO $$
+		BEGIN
+			IF EXISTS (
+				SELECT 1 FROM information_schema.columns
+				WHERE table_name = 'pokemon_raw' AND column_name = 'payload' AND data_type = 'json'
+			) THEN
+				ALTER TABLE pokemon_raw ALTER COLUMN payload TYPE JSONB USING payload::jsonb;
+			END IF;
+		END $$`,
+
+		// Bumped on every save; the newest value versions cached responses
+		`ALTER TABLE pokemon ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP`,
+
+		// Pokemon name aliases (compact alias -> canonical PokeAPI name)
+		`CREATE TABLE IF NOT EXISTS pokemon_aliases (
+			alias VARCHAR(100) PRIMARY KEY,
+			pokemon_name VARCHAR(100) NOT NULL
+		)`,
+
+		// Gen 5 species whose default PokeAPI entry is a form name
+		`INSERT INTO pokemon_aliases (alias, pokemon_name) VALUES
+			('basculin', 'basculin-red-striped'),
+			('darmanitan', 'darmanitan-standard'),
+			('tornadus', 'tornadus-incarnate'),
+			('thundurus', 'thundurus-incarnate'),
+			('landorus', 'landorus-incarnate'),
+			('keldeo', 'keldeo-ordinary'),
+			('meloetta', 'meloetta-aria')
+		ON CONFLICT (alias) DO NOTHING`,
+		
+		// Pokemon of the day, picked on first request for each date
+		`CREATE TABLE IF NOT EXISTS daily_pokemon (
+			day DATE PRIMARY KEY,
+			pokedex_id INT NOT NULL,
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		)`,
+		
+		// Natures and the stat each raises and lowers by 10% (neutral natures have neither)
+		`CREATE TABLE IF NOT EXISTS natures (
+			id INT PRIMARY KEY,
+			name VARCHAR(20) UNIQUE NOT NULL,
+			increased_stat VARCHAR(20),
+			decreased_stat VARCHAR(20)
+		)`,
+
+		`INSERT INTO natures (id, name, increased_stat, decreased_stat) VALUES
+			(0, 'hardy', NULL, NULL),
+			(1, 'lonely', 'attack', 'defense'),
+			(2, 'brave', 'attack', 'speed'),
+			(3, 'adamant', 'attack', 'special_attack'),
+			(4, 'naughty', 'attack', 'special_defense'),
+			(5, 'bold', 'defense', 'attack'),
+			(6, 'docile', NULL, NULL),
+			(7, 'relaxed', 'defense', 'speed'),
+			(8, 'impish', 'defense', 'special_attack'),
+			(9, 'lax', 'defense', 'special_defense'),
+			(10, 'timid', 'speed', 'attack'),
+			(11, 'hasty', 'speed', 'defense'),
+			(12, 'serious', NULL, NULL),
+			(13, 'jolly', 'speed', 'special_attack'),
+			(14, 'naive', 'speed', 'special_defense'),
+			(15, 'modest', 'special_attack', 'attack'),
+			(16, 'mild', 'special_attack', 'defense'),
+			(17, 'quiet', 'special_attack', 'speed'),
+			(18, 'bashful', NULL, NULL),
+			(19, 'rash', 'special_attack', 'special_defense'),
+			(20, 'calm', 'special_defense', 'attack'),
+			(21, 'gentle', 'special_defense', 'defense'),
+			(22, 'sassy', 'special_defense', 'speed'),
+			(23, 'careful', 'special_defense', 'special_attack'),
+			(24, 'quirky', NULL, NULL)
+		ON CONFLICT (id) DO NOTHING`,
+		
+		// Rank and percentile of every base stat, overall (scope '') and within each type.
+		// Refreshed after each sync so reads don't recompute the window functions.
+		`CREATE MATERIALIZED VIEW IF NOT EXISTS pokemon_stat_ranks AS
+		WITH stat_values AS (
+			SELECT p.pokedex_id, v.stat, v.value
+			FROM pokemon p
+			INNER JOIN pokemon_stats ps ON ps.pokemon_id = p.id
+			CROSS JOIN LATERAL (VALUES
+				('hp', ps.hp),
+				('attack', ps.attack),
+				('defense', ps.defense),
+				('special_attack', ps.special_attack),
+				('special_defense', ps.special_defense),
+				('speed', ps.speed),
+				('base_stat_total', ps.hp + ps.attack + ps.defense + ps.special_attack + ps.special_defense + ps.speed)
+			) AS v(stat, value)
+		),
+		scoped AS (
+			SELECT sv.pokedex_id, sv.stat, sv.value, ''::text AS scope FROM stat_values sv
+			UNION ALL
+			SELECT sv.pokedex_id, sv.stat, sv.value, pt.type_name::text
+			FROM stat_values sv
+			INNER JOIN pokemon p ON p.pokedex_id = sv.pokedex_id
+			INNER JOIN pokemon_types pt ON pt.pokemon_id = p.id
+		)
+		SELECT pokedex_id, stat, scope, value,
+			RANK() OVER (PARTITION BY stat, scope ORDER BY value DESC) AS rank,
+			COUNT(*) OVER (PARTITION BY stat, scope) AS total,
+			ROUND((percent_rank() OVER (PARTITION BY stat, scope ORDER BY value) * 100)::numeric, 1)::float8 AS percentile
+		FROM scoped`,
+		
 		// Indexes for better performance
 		`CREATE INDEX IF NOT EXISTS idx_pokemon_pokedex_id ON pokemon(pokedex_id)`,
 		`CREATE INDEX IF NOT EXISTS idx_pokemon_name ON pokemon(name)`,
@@ -92,6 +208,12 @@ func RunMigrations(db *sql.DB) error {
 		`CREATE INDEX IF NOT EXISTS idx_pokemon_abilities_pokemon_id ON pokemon_abilities(pokemon_id)`,
 		`CREATE INDEX IF NOT EXISTS idx_pokemon_stats_pokemon_id ON pokemon_stats(pokemon_id)`,
 		`CREATE INDEX IF NOT EXISTS idx_pokemon_types_type_name ON pokemon_types(type_name)`,
+		`DROP INDEX IF EXISTS idx_pokemon_raw_pokemon_id`, // UNIQUE(pokemon_id) already indexes it
+		`CREATE INDEX IF NOT EXISTS idx_pokemon_name_trgm ON pokemon USING GIN (name gin_trgm_ops)`,
+		`CREATE INDEX IF NOT EXISTS idx_pokemon_name_pattern ON pokemon(name text_pattern_ops)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_pokemon_stat_ranks ON pokemon_stat_ranks(stat, scope, pokedex_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_pokemon_stat_ranks_pokedex_id ON pokemon_stat_ranks(pokedex_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_pokemon_updated_at ON pokemon(updated_at)`,
 	}
 
 	// Execute each migration
@@ -0,0 +1,228 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"pokeAPI/service"
+	"strconv"
+	"strings"
+)
+
+// CalculateDamage handles POST /api/calc/damage
+func (c *PokemonController) CalculateDamage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	var req service.DamageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeErrorStatus(w, http.StatusBadRequest, "Request body must be a JSON object with attacker, defender and move")
+		return
+	}
+
+	result, err := c.service.CalculateDamage(req)
+	if err != nil {
+		writeError(w, err, "Failed to calculate")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// GetNatures handles GET /api/natures
+func (c *PokemonController) GetNatures(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	natures, err := c.service.GetNatures()
+	if err != nil {
+		writeError(w, err, "Failed to retrieve natures")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    natures,
+	})
+}
+
+// CalculateStats handles GET /api/pokemon/{id}/stats/calculate?level=&nature=&ivs=&evs=
+func (c *PokemonController) CalculateStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	id, ok := c.resolvePokemon(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+
+	level := 0
+	if l := query.Get("level"); l != "" {
+		parsed, err := strconv.Atoi(l)
+		if err != nil {
+			writeFieldError(w, "level", "level must be an integer")
+			return
+		}
+		level = parsed
+	}
+
+	ivs, err := parseStatSpread("ivs", query.Get("ivs"))
+	if err != nil {
+		writeError(w, err, "Invalid request")
+		return
+	}
+	evs, err := parseStatSpread("evs", query.Get("evs"))
+	if err != nil {
+		writeError(w, err, "Invalid request")
+		return
+	}
+
+	result, err := c.service.CalculateStats(id, level, query.Get("nature"), ivs, evs)
+	if err != nil {
+		writeError(w, err, "Failed to calculate")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// parseStatSpread reads a spread given either as six numbers in hp,attack,defense,special_attack,
+// special_defense,speed order or as stat:value pairs (e.g. "special_attack:252,speed:252")
+func parseStatSpread(param, value string) (service.StatSpread, error) {
+	items := splitList(value)
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	spread := service.StatSpread{}
+	if !strings.Contains(value, ":") {
+		if len(items) != len(service.StatNames) {
+			return nil, invalidParam(param, "%s must list %d values or stat:value pairs", param, len(service.StatNames))
+		}
+		for i, item := range items {
+			n, err := strconv.Atoi(item)
+			if err != nil {
+				return nil, invalidParam(param, "%s must be integers", param)
+			}
+			spread[service.StatNames[i]] = n
+		}
+		return spread, nil
+	}
+
+	for _, item := range items {
+		stat, v, ok := strings.Cut(item, ":")
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if !ok || err != nil {
+			return nil, invalidParam(param, "%s must be stat:value pairs", param)
+		}
+		spread[service.NormalizeName(stat)] = n
+	}
+	return spread, nil
+}
+
+// CalculateIVs handles POST /api/pokemon/{id}/ivs with a nature and one or more observations:
+// {"nature": "timid", "observations": [{"level": 50, "evs": {...}, "stats": {"hp": 135, ...}}]}.
+// A single observation may also be sent as top-level level, evs and stats.
+func (c *PokemonController) CalculateIVs(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	id, ok := c.resolvePokemon(w, r)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Nature       string                    `json:"nature"`
+		Observations []service.StatObservation `json:"observations"`
+		service.StatObservation
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeErrorStatus(w, http.StatusBadRequest, "Request body must be a JSON object with a nature and observations")
+		return
+	}
+	if body.Stats != nil {
+		body.Observations = append(body.Observations, body.StatObservation)
+	}
+
+	result, err := c.service.CalculateIVs(id, body.Nature, body.Observations)
+	if err != nil {
+		writeError(w, err, "Failed to calculate")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// HiddenPower handles GET /api/calc/hidden-power?ivs= for a spread's Hidden Power, and
+// GET /api/calc/hidden-power?type=&pokemon= for spreads that reach a type
+func (c *PokemonController) HiddenPower(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	query := r.URL.Query()
+
+	var result map[string]interface{}
+	var err error
+	switch {
+	case query.Get("ivs") != "":
+		ivs, parseErr := parseStatSpread("ivs", query.Get("ivs"))
+		if parseErr != nil {
+			writeError(w, parseErr, "Invalid request")
+			return
+		}
+		result, err = service.CalculateHiddenPower(ivs)
+	case query.Get("type") != "":
+		pokedexID := 0
+		if pokemon := query.Get("pokemon"); pokemon != "" {
+			pokedexID, _, err = c.service.ResolvePokemon(pokemon)
+			if err != nil {
+				writeError(w, err, "Failed to calculate")
+				return
+			}
+		}
+		result, err = c.service.HiddenPowerSpreads(query.Get("type"), pokedexID)
+	default:
+		writeErrorStatus(w, http.StatusBadRequest, "Either ivs or type is required")
+		return
+	}
+	if err != nil {
+		writeError(w, err, "Failed to calculate")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    result,
+	})
+}
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"pokeAPI/service"
+	"strconv"
+	"strings"
+)
+
+// ComparePokemon handles GET /api/pokemon/compare?ids=635,637
+func (c *PokemonController) ComparePokemon(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	idsOrNames := splitList(r.URL.Query().Get("ids"))
+	if len(idsOrNames) < service.MinCompareSize || len(idsOrNames) > service.MaxCompareSize {
+		writeFieldError(w, "ids", fmt.Sprintf("ids must list %d to %d Pokemon", service.MinCompareSize, service.MaxCompareSize))
+		return
+	}
+
+	comparison, missing, err := c.service.ComparePokemon(idsOrNames)
+	if err != nil {
+		writeError(w, err, "Failed to compare pokemon")
+		return
+	}
+	if len(missing) > 0 {
+		writeErrorStatus(w, http.StatusNotFound, "Pokemon not found: "+strings.Join(missing, ", "))
+		return
+	}
+	if pokemons, _ := comparison["pokemon"].([]map[string]interface{}); len(pokemons) < service.MinCompareSize {
+		writeFieldError(w, "ids", fmt.Sprintf("ids must list at least %d different Pokemon", service.MinCompareSize))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    comparison,
+	})
+}
+
+// SimilarPokemon handles GET /api/pokemon/{id}/similar?limit=&metric=&type_weight=&ability_weight=
+func (c *PokemonController) SimilarPokemon(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	id, ok := c.resolvePokemon(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+
+	limit := 0
+	if l := query.Get("limit"); l != "" {
+		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+
+	opts := service.SimilarOptions{Metric: query.Get("metric")}
+	for param, weight := range map[string]*float64{"type_weight": &opts.TypeWeight, "ability_weight": &opts.AbilityWeight} {
+		if v := query.Get(param); v != "" {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				writeFieldError(w, param, param+" must be a number")
+				return
+			}
+			*weight = parsed
+		}
+	}
+
+	similar, err := c.service.SimilarPokemon(id, limit, opts)
+	if err != nil {
+		writeError(w, err, "Failed to calculate")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    similar,
+	})
+}
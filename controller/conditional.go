@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Conditional wraps a GET handler with HTTP caching. Responses get a strong ETag built from the
+// data version, the request URL and the base URL links are built from, Last-Modified from the last
+// save or sync, and the given Cache-Control. Matching If-None-Match or If-Modified-Since requests
+// get a 304 without querying.
+func (c *PokemonController) Conditional(cacheControl string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			next(w, r)
+			return
+		}
+
+		version, lastModified, err := c.service.DataVersion()
+		if err != nil {
+			// Serve uncached rather than fail the request
+			log.Printf("Error getting data version: %v", err)
+			next(w, r)
+			return
+		}
+
+		// The same data, URL and link base always encode to the same bytes, so the tag can be strong
+		base := c.baseURL(r)
+		sum := sha256.Sum256([]byte(version + "|" + base + "|" + r.URL.Path + "?" + r.URL.Query().Encode()))
+		etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+		header := w.Header()
+		// Links follow the request's host unless a public base URL is configured
+		if c.publicBaseURL == "" {
+			header.Add("Vary", "Host")
+			if c.trustProxyHeaders {
+				header.Add("Vary", "X-Forwarded-Proto, X-Forwarded-Host")
+			}
+		}
+		header.Set("ETag", etag)
+		header.Set("Last-Modified", lastModified.Format(http.TimeFormat))
+		if cacheControl != "" {
+			header.Set("Cache-Control", cacheControl)
+		}
+
+		if notModified(r, etag, lastModified) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		next(&cacheableWriter{ResponseWriter: w}, r)
+	}
+}
+
+// notModified applies If-None-Match with weak comparison (so W/"..." from a compressing proxy
+// still matches), falling back to If-Modified-Since only when no ETag was sent
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		for _, candidate := range strings.Split(match, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == etag || candidate == "*" {
+				return true
+			}
+		}
+		return false
+	}
+
+	if since := r.Header.Get("If-Modified-Since"); since != "" {
+		t, err := http.ParseTime(since)
+		return err == nil && !lastModified.Truncate(time.Second).After(t)
+	}
+	return false
+}
+
+// cacheableWriter drops the caching headers from anything but a 200, so errors and redirects
+// are never stored by a CDN under the data's ETag
+type cacheableWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+func (w *cacheableWriter) WriteHeader(status int) {
+	if !w.wroteHeader && status != http.StatusOK {
+		header := w.Header()
+		header.Del("ETag")
+		header.Del("Last-Modified")
+		header.Del("Cache-Control")
+	}
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *cacheableWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"pokeAPI/service"
+)
+
+// RequestIDHeader carries the request ID on requests and responses
+const RequestIDHeader = "X-Request-ID"
+
+// Machine-readable error codes used in error responses
+const (
+	CodeInvalidArgument  = "invalid_argument"
+	CodeNotFound         = "not_found"
+	CodeConflict         = "conflict"
+	CodeUpstream         = "upstream_error"
+	CodeMethodNotAllowed = "method_not_allowed"
+	CodeInternal         = "internal_error"
+)
+
+// errorKinds maps each kind of service error to its status and code
+var errorKinds = []struct {
+	kind   error
+	status int
+	code   string
+}{
+	{service.ErrInvalidArgument, http.StatusBadRequest, CodeInvalidArgument},
+	{service.ErrNotFound, http.StatusNotFound, CodeNotFound},
+	{service.ErrConflict, http.StatusConflict, CodeConflict},
+	{service.ErrUpstream, http.StatusBadGateway, CodeUpstream},
+}
+
+// FieldError points an error at one input field
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ErrorBody is the "error" object of an error response
+type ErrorBody struct {
+	Code      string       `json:"code"`
+	Message   string       `json:"message"`
+	RequestID string       `json:"request_id,omitempty"`
+	Fields    []FieldError `json:"fields,omitempty"`
+}
+
+// RequestID gives every request an ID, reusing the caller's X-Request-ID when it sent one,
+// and echoes it in the response so error bodies and logs can be matched up
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if id == "" || len(id) > 128 {
+			id = newRequestID()
+			r.Header.Set(RequestIDHeader, id)
+		}
+		w.Header().Set(RequestIDHeader, id)
+		next.ServeHTTP(w, r)
+	})
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// writeError writes a service error with the status and code for its kind. Errors of no known
+// kind are logged and reported as a 500 with fallback as the message, so internals don't leak.
+func writeError(w http.ResponseWriter, err error, fallback string) {
+	for _, k := range errorKinds {
+		if !errors.Is(err, k.kind) {
+			continue
+		}
+		body := ErrorBody{Code: k.code, Message: err.Error()}
+		var serviceErr *service.Error
+		if errors.As(err, &serviceErr) && serviceErr.Field != "" {
+			body.Fields = []FieldError{{Field: serviceErr.Field, Message: serviceErr.Message}}
+		}
+		if k.kind == service.ErrUpstream {
+			log.Printf("[%s] %s: %v", w.Header().Get(RequestIDHeader), fallback, err)
+			body.Message = fallback
+		}
+		writeErrorBody(w, k.status, body)
+		return
+	}
+
+	log.Printf("[%s] %s: %v", w.Header().Get(RequestIDHeader), fallback, err)
+	writeErrorStatus(w, http.StatusInternalServerError, fallback)
+}
+
+// writeErrorStatus writes an error the controller detected itself, such as a bad query parameter
+func writeErrorStatus(w http.ResponseWriter, status int, message string) {
+	writeErrorBody(w, status, ErrorBody{Code: statusCode(status), Message: message})
+}
+
+// writeFieldError writes a 400 for one bad input field
+func writeFieldError(w http.ResponseWriter, field, message string) {
+	writeErrorBody(w, http.StatusBadRequest, ErrorBody{
+		Code:    CodeInvalidArgument,
+		Message: message,
+		Fields:  []FieldError{{Field: field, Message: message}},
+	})
+}
+
+// writeErrorBody writes the error envelope, stamped with the request ID set by RequestID
+func writeErrorBody(w http.ResponseWriter, status int, body ErrorBody) {
+	body.RequestID = w.Header().Get(RequestIDHeader)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": false,
+		"error":   body,
+	})
+}
+
+// statusCode picks the error code for a status written by the controller itself
+func statusCode(status int) string {
+	switch status {
+	case http.StatusMethodNotAllowed:
+		return CodeMethodNotAllowed
+	case http.StatusInternalServerError:
+		return CodeInternal
+	}
+	for _, k := range errorKinds {
+		if k.status == status {
+			return k.code
+		}
+	}
+	return CodeInternal
+}
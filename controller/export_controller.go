@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"log"
+	"net/http"
+	"pokeAPI/service"
+)
+
+// Export formats accepted by ?format=
+var exportContentTypes = map[string]string{
+	"csv":    "text/csv; charset=utf-8",
+	"ndjson": "application/x-ndjson",
+	"json":   "application/json",
+}
+
+// ExportPokemon handles GET /api/export?format=csv|ndjson|json, taking the same filters as GET /api/pokemon.
+// Rows are written as they come off the database cursor, so the response is never held in memory.
+func (c *PokemonController) ExportPokemon(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	query := r.URL.Query()
+
+	format := query.Get("format")
+	if format == "" {
+		format = "csv"
+	}
+	contentType, ok := exportContentTypes[format]
+	if !ok {
+		writeFieldError(w, "format", "format must be csv, ndjson or json")
+		return
+	}
+
+	filter, err := parsePokemonFilter(query)
+	if err != nil {
+		writeError(w, err, "Invalid request")
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="pokedex.`+format+`"`)
+	flusher, _ := w.(http.Flusher)
+
+	csvWriter := csv.NewWriter(w)
+	encoder := json.NewEncoder(w)
+	written := 0
+
+	// The body opens with the first row, so a failing query can still get a proper error status
+	started := false
+	start := func() {
+		started = true
+		switch format {
+		case "csv":
+			csvWriter.Write(service.ExportColumns)
+		case "json":
+			w.Write([]byte("["))
+		}
+	}
+
+	err = c.service.ExportPokemon(filter, func(row *service.ExportRow) error {
+		if !started {
+			start()
+		}
+		switch format {
+		case "csv":
+			if err := csvWriter.Write(row.Record()); err != nil {
+				return err
+			}
+		case "ndjson":
+			if err := encoder.Encode(row); err != nil {
+				return err
+			}
+		case "json":
+			if written > 0 {
+				w.Write([]byte(","))
+			}
+			if err := encoder.Encode(row); err != nil {
+				return err
+			}
+		}
+		written++
+
+		// Push each batch to the client instead of buffering the whole export
+		if written%service.ExportBatchSize == 0 {
+			csvWriter.Flush()
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if !started {
+			w.Header().Del("Content-Disposition")
+			writeError(w, err, "Failed to export pokemon")
+			return
+		}
+		// Otherwise the status is already sent; all we can do is cut the body short
+		log.Printf("Error exporting pokemon after %d rows: %v", written, err)
+		return
+	}
+
+	if !started {
+		start()
+	}
+	switch format {
+	case "csv":
+		csvWriter.Flush()
+	case "json":
+		w.Write([]byte("]\n"))
+	}
+}
@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"pokeAPI/service"
+	"strconv"
+	"strings"
+)
+
+// parsePokemonFilter reads the shared list filters from query parameters:
+// q, type, type_match, types_exact, ability, hidden_ability, generation and min_<field>/max_<field>
+func parsePokemonFilter(query url.Values) (service.PokemonFilter, error) {
+	filter := service.PokemonFilter{
+		Query:         query.Get("q"),
+		Types:         splitList(query.Get("type")),
+		TypeMatch:     service.TypeMatchAny,
+		TypesExact:    splitList(query.Get("types_exact")),
+		Ability:       query.Get("ability"),
+		HiddenAbility: query.Get("hidden_ability"),
+		Min:           map[string]int{},
+		Max:           map[string]int{},
+	}
+
+	if match := query.Get("type_match"); match != "" {
+		if match != service.TypeMatchAny && match != service.TypeMatchAll {
+			return filter, invalidParam("type_match", "type_match must be %q or %q", service.TypeMatchAny, service.TypeMatchAll)
+		}
+		filter.TypeMatch = match
+	}
+
+	if g := query.Get("generation"); g != "" {
+		generation, err := strconv.Atoi(g)
+		if _, ok := service.GenerationRanges[generation]; err != nil || !ok {
+			return filter, invalidParam("generation", "generation must be between 1 and %d", len(service.GenerationRanges))
+		}
+		filter.Generation = generation
+	}
+
+	for field := range service.RangeFilterFields {
+		if v := query.Get("min_" + field); v != "" {
+			parsed, err := strconv.Atoi(v)
+			if err != nil {
+				return filter, invalidParam("min_"+field, "min_%s must be an integer", field)
+			}
+			filter.Min[field] = parsed
+		}
+		if v := query.Get("max_" + field); v != "" {
+			parsed, err := strconv.Atoi(v)
+			if err != nil {
+				return filter, invalidParam("max_"+field, "max_%s must be an integer", field)
+			}
+			filter.Max[field] = parsed
+		}
+	}
+
+	return filter, nil
+}
+
+// invalidParam reports a bad query parameter or body field as an invalid argument
+func invalidParam(field, format string, args ...interface{}) error {
+	return &service.Error{
+		Kind:    service.ErrInvalidArgument,
+		Message: fmt.Sprintf(format, args...),
+		Field:   field,
+	}
+}
+
+// splitList splits a comma-separated query value, dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// jsonIDs converts a decoded JSON array of Pokedex numbers and names to strings
+func jsonIDs(values []interface{}) ([]string, error) {
+	idsOrNames := make([]string, 0, len(values))
+	for _, id := range values {
+		switch v := id.(type) {
+		case float64:
+			// Truncating 494.7 would fetch a Pokemon nobody asked for
+			if v != math.Trunc(v) {
+				return nil, invalidParam("ids", "ids must be whole numbers, got %v", v)
+			}
+			idsOrNames = append(idsOrNames, strconv.Itoa(int(v)))
+		case string:
+			idsOrNames = append(idsOrNames, v)
+		default:
+			return nil, invalidParam("ids", "ids must be numbers or names")
+		}
+	}
+	return idsOrNames, nil
+}
+
+// pokemonFields are the fields ?fields= can select on list and detail responses
+var pokemonFields = []string{"id", "name", "height", "weight", "sprite_url", "animated_front", "animated_back", "created_at", "types"}
+
+// parseFieldsets reads ?fields= and ?include=, rejecting unknown names.
+// Included relations are always returned, whether or not they are listed in fields.
+func parseFieldsets(query url.Values) (fields, include []string, err error) {
+	include = splitList(query.Get("include"))
+	for _, relation := range include {
+		if !contains(service.ValidIncludes, relation) {
+			return nil, nil, invalidParam("include", "unknown include %q, expected one of %s", relation, strings.Join(service.ValidIncludes, ","))
+		}
+	}
+
+	fields = splitList(query.Get("fields"))
+	for _, field := range fields {
+		if !contains(pokemonFields, field) && !contains(service.ValidIncludes, field) {
+			return nil, nil, invalidParam("fields", "unknown field %q", field)
+		}
+	}
+	if len(fields) > 0 {
+		fields = append(fields, include...)
+	}
+
+	return fields, include, nil
+}
+
+// selectFields trims a response item down to the requested fields, or returns it unchanged when none were requested
+func selectFields(item map[string]interface{}, fields []string) map[string]interface{} {
+	if len(fields) == 0 {
+		return item
+	}
+	trimmed := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		if value, ok := item[field]; ok {
+			trimmed[field] = value
+		}
+	}
+	return trimmed
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
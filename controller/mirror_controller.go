@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"pokeAPI/service"
+	"strconv"
+	"strings"
+)
+
+// PokeAPI's own defaults for the list endpoint
+const (
+	mirrorDefaultLimit = 20
+	mirrorMaxLimit     = 10000
+)
+
+// MirrorPokemon handles GET /api/v2/pokemon/{id or name}, a drop-in for PokeAPI's endpoint.
+// Responses use PokeAPI's shapes, without our success envelope. Errors deliberately stay
+// plain text like PokeAPI's own rather than using writeError, so PokeAPI clients handle them unchanged.
+func (c *PokemonController) MirrorPokemon(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// PokeAPI serves the list at /pokemon/ as well as /pokemon
+	pathParts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(pathParts) < 4 || pathParts[3] == "" {
+		c.MirrorPokemonList(w, r)
+		return
+	}
+
+	// Sub-resources such as /pokemon/{id}/encounters aren't mirrored
+	if len(pathParts) > 4 {
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+
+	id, _, err := c.service.ResolvePokemon(pathParts[3])
+	if err == nil {
+		var pokemon interface{}
+		pokemon, err = c.service.GetPokeAPIPokemon(id, c.baseURL(r)+"/api/v2")
+		if err == nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(pokemon)
+			return
+		}
+	}
+
+	// PokeAPI answers unknown Pokemon with a plain "Not Found", so the mirror does too
+	if errors.Is(err, service.ErrNotFound) {
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+	log.Printf("Error getting pokemon: %v", err)
+	http.Error(w, "Failed to retrieve pokemon", http.StatusInternalServerError)
+}
+
+// MirrorPokemonList handles GET /api/v2/pokemon?limit=&offset= with PokeAPI's list envelope
+func (c *PokemonController) MirrorPokemonList(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+
+	limit := mirrorDefaultLimit
+	if l := query.Get("limit"); l != "" {
+		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 {
+			limit = min(parsed, mirrorMaxLimit)
+		}
+	}
+
+	offset := 0
+	if o := query.Get("offset"); o != "" {
+		if parsed, err := strconv.Atoi(o); err == nil && parsed >= 0 {
+			offset = parsed
+		}
+	}
+
+	resources, count, err := c.service.ListPokemonResources(limit, offset)
+	if err != nil {
+		log.Printf("Error listing pokemon: %v", err)
+		http.Error(w, "Failed to retrieve pokemon", http.StatusInternalServerError)
+		return
+	}
+
+	base := c.baseURL(r) + "/api/v2/pokemon"
+
+	results := make([]map[string]string, len(resources))
+	for i, resource := range resources {
+		results[i] = map[string]string{
+			"name": resource["name"].(string),
+			"url":  fmt.Sprintf("%s/%d/", base, resource["id"]),
+		}
+	}
+
+	var next, previous interface{}
+	if offset+limit < count {
+		next = fmt.Sprintf("%s?offset=%d&limit=%d", base, offset+limit, limit)
+	}
+	if offset > 0 {
+		previous = fmt.Sprintf("%s?offset=%d&limit=%d", base, max(offset-limit, 0), limit)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"count":    count,
+		"next":     next,
+		"previous": previous,
+		"results":  results,
+	})
+}
+
+// baseURL is the scheme and host links are built from: PUBLIC_BASE_URL when configured, otherwise
+// the request's own. X-Forwarded-Proto and X-Forwarded-Host are only honoured with TRUST_PROXY_HEADERS,
+// since any client can send them.
+func (c *PokemonController) baseURL(r *http.Request) string {
+	if c.publicBaseURL != "" {
+		return c.publicBaseURL
+	}
+
+	scheme, host := "http", r.Host
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if c.trustProxyHeaders {
+		if proto := r.Header.Get("X-Forwarded-Proto"); proto == "http" || proto == "https" {
+			scheme = proto
+		}
+		if forwarded := r.Header.Get("X-Forwarded-Host"); forwarded != "" {
+			host = forwarded
+		}
+	}
+	return scheme + "://" + host
+}
@@ -2,8 +2,10 @@ package controller
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"net/http"
+	"pokeAPI/config"
 	"pokeAPI/service"
 	"strconv"
 	"strings"
@@ -12,25 +14,42 @@ import (
 // PokemonController handles HTTP requests for Pokemon
 type PokemonController struct {
 	service *service.PokemonService
+	dailyNoRepeatDays int
+	publicBaseURL string
+	trustProxyHeaders bool
 }
 
 // NewPokemonController creates a new Pokemon controller
-func NewPokemonController(service *service.PokemonService) *PokemonController {
+func NewPokemonController(service *service.PokemonService, cfg *config.Config) *PokemonController {
 	return &PokemonController{
 		service: service,
+		dailyNoRepeatDays: cfg.DailyNoRepeatDays,
+		publicBaseURL: cfg.PublicBaseURL,
+		trustProxyHeaders: cfg.TrustProxyHeaders,
 	}
 }
 
 // GetAllPokemon handles GET /api/pokemon
 func (c *PokemonController) GetAllPokemon(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
 		return
 	}
 	
 	// Parse query parameters
 	query := r.URL.Query()
 	
+	// ?ids= fetches specific Pokemon instead of a page
+	if ids := query.Get("ids"); ids != "" {
+		fields, include, err := parseFieldsets(query)
+		if err != nil {
+			writeError(w, err, "Invalid request")
+			return
+		}
+		c.writeBatch(w, splitList(ids), fields, include)
+		return
+	}
+	
 	// Pagination
 	limit := 20
 	if l := query.Get("limit"); l != "" {
@@ -53,104 +72,217 @@ func (c *PokemonController) GetAllPokemon(w http.ResponseWriter, r *http.Request
 		}
 	}
 	
-	// Sorting
+	// Sorting (searches rank by relevance unless a sort is given)
 	sortBy := query.Get("sort")
 	if sortBy == "" {
 		sortBy = "pokedex_id"
+		if query.Get("q") != "" {
+			sortBy = service.SortRelevance
+		}
 	}
 	
 	order := query.Get("order")
 	if order == "" {
 		order = "asc"
+		if sortBy == service.SortRelevance {
+			order = "desc"
+		}
 	}
 	
 	// Filtering
-	typeFilter := query.Get("type")
+	filter, err := parsePokemonFilter(query)
+	if err != nil {
+		writeError(w, err, "Invalid request")
+		return
+	}
 	
-	// Get paginated results
-	result, err := c.service.GetPokemonPaginated(limit, offset, sortBy, order, typeFilter)
+	// Sparse fieldsets and related data
+	fields, include, err := parseFieldsets(query)
+	if err != nil {
+		writeError(w, err, "Invalid request")
+		return
+	}
+	
+	// Get paginated results (a cursor switches to keyset paging)
+	var result map[string]interface{}
+	if cursor := query.Get("cursor"); cursor != "" {
+		result, err = c.service.GetPokemonByCursor(limit, cursor, filter, include)
+	} else {
+		result, err = c.service.GetPokemonPaginated(limit, offset, sortBy, order, filter, include)
+	}
 	if err != nil {
-		log.Printf("Error getting pokemon: %v", err)
-		http.Error(w, "Failed to retrieve pokemon", http.StatusInternalServerError)
+		writeError(w, err, "Failed to retrieve pokemon")
 		return
 	}
 	
+	pokemons, _ := result["data"].([]map[string]interface{})
+	for i, pokemon := range pokemons {
+		pokemons[i] = selectFields(pokemon, fields)
+	}
+	
+	// Everything except the rows themselves is pagination info
+	pagination := map[string]interface{}{}
+	for key, value := range result {
+		if key != "data" {
+			pagination[key] = value
+		}
+	}
+	
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"success": true,
-		"data":    result["data"],
-		"pagination": map[string]interface{}{
-			"total":        result["total"],
-			"page":         result["page"],
-			"limit":        result["limit"],
-			"total_pages":  result["total_pages"],
-			"has_next":     result["has_next"],
-			"has_previous": result["has_previous"],
-		},
+		"success":    true,
+		"data":       pokemons,
+		"pagination": pagination,
 	})
 }
 
-// GetPokemonByID handles GET /api/pokemon/{id}
+// GetPokemonByID handles GET /api/pokemon/{id or name}
 func (c *PokemonController) GetPokemonByID(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
 		return
 	}
 
-	// Extract ID from URL path
-	pathParts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
-	if len(pathParts) < 3 {
-		http.Error(w, "Invalid URL", http.StatusBadRequest)
+	id, ok := c.resolvePokemon(w, r)
+	if !ok {
 		return
 	}
 
-	id, err := strconv.Atoi(pathParts[2])
+	fields, include, err := parseFieldsets(r.URL.Query())
 	if err != nil {
-		http.Error(w, "Invalid pokemon ID", http.StatusBadRequest)
+		writeError(w, err, "Invalid request")
 		return
 	}
 
-	pokemon, err := c.service.GetPokemonByID(id)
+	pokemon, err := c.service.GetPokemonDetail(id, include)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			http.Error(w, "Pokemon not found", http.StatusNotFound)
-			return
-		}
-		log.Printf("Error getting pokemon: %v", err)
-		http.Error(w, "Failed to retrieve pokemon", http.StatusInternalServerError)
+		writeError(w, err, "Failed to retrieve pokemon")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    selectFields(pokemon, fields),
+	})
+}
+
+// BatchPokemon handles POST /api/pokemon/batch with {"ids": [494, "zoroark", ...]}
+func (c *PokemonController) BatchPokemon(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	var body struct {
+		IDs []interface{} `json:"ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeErrorStatus(w, http.StatusBadRequest, "Request body must be a JSON object with an ids array")
+		return
+	}
+
+	idsOrNames, err := jsonIDs(body.IDs)
+	if err != nil {
+		writeError(w, err, "Invalid request")
+		return
+	}
+
+	fields, include, err := parseFieldsets(r.URL.Query())
+	if err != nil {
+		writeError(w, err, "Invalid request")
+		return
+	}
+
+	c.writeBatch(w, idsOrNames, fields, include)
+}
+
+// writeBatch looks up many Pokemon at once and writes them in request order, with unmatched inputs under "missing"
+func (c *PokemonController) writeBatch(w http.ResponseWriter, idsOrNames []string, fields, include []string) {
+	if len(idsOrNames) == 0 {
+		writeFieldError(w, "ids", "At least one id is required")
+		return
+	}
+	if len(idsOrNames) > service.MaxBatchSize {
+		writeFieldError(w, "ids", fmt.Sprintf("At most %d ids per request", service.MaxBatchSize))
+		return
+	}
+
+	pokemons, missing, err := c.service.GetPokemonBatch(idsOrNames, include)
+	if err != nil {
+		writeError(w, err, "Failed to retrieve pokemon")
 		return
 	}
 
+	for i, pokemon := range pokemons {
+		pokemons[i] = selectFields(pokemon, fields)
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"success": true,
-		"data":    pokemon,
+		"data":    pokemons,
+		"missing": missing,
 	})
 }
 
+// resolvePokemon resolves the {id or name} path segment of a /api/pokemon/... request.
+// GET and HEAD requests for non-canonical names (other casing, aliases, species names) are redirected
+// to the canonical path; other methods are served at the resolved ID, since clients may re-send a
+// redirected POST as a GET. It returns false once a response has already been written.
+func (c *PokemonController) resolvePokemon(w http.ResponseWriter, r *http.Request) (int, bool) {
+	// Extract ID or name from URL path
+	pathParts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(pathParts) < 3 || pathParts[2] == "" {
+		writeErrorStatus(w, http.StatusBadRequest, "Invalid URL")
+		return 0, false
+	}
+
+	id, name, err := c.service.ResolvePokemon(pathParts[2])
+	if err != nil {
+		writeError(w, err, "Failed to retrieve pokemon")
+		return 0, false
+	}
+
+	isRead := r.Method == http.MethodGet || r.Method == http.MethodHead
+	if isRead && name != "" && name != pathParts[2] {
+		pathParts[2] = name
+		target := "/" + strings.Join(pathParts, "/")
+		if r.URL.RawQuery != "" {
+			target += "?" + r.URL.RawQuery
+		}
+		http.Redirect(w, r, target, http.StatusMovedPermanently)
+		return 0, false
+	}
+
+	return id, true
+}
+
 // SyncGen5Pokemon handles POST /api/pokemon/sync
 func (c *PokemonController) SyncGen5Pokemon(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
 		return
 	}
 
 	log.Println("Starting Gen 5 Pokemon sync via API...")
 
 	// Run sync in background (this takes time!)
-	go func() {
-		if err := c.service.SyncGen5Pokemon(); err != nil {
-			log.Printf("Sync failed: %v", err)
-		}
-	}()
+	job, err := c.service.StartSyncJob()
+	if err != nil {
+		writeError(w, err, "Failed to start sync")
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusAccepted)
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"success": true,
 		"message": "Gen 5 Pokemon sync started. This will take a few minutes. Check logs for progress.",
+		"job_id":  job.ID,
 	})
 }
 
@@ -166,14 +298,13 @@ func HealthCheck(w http.ResponseWriter, r *http.Request) {
 
 func (c *PokemonController) GetSyncStatus(w http.ResponseWriter, r *http.Request){
 	if r.Method != http.MethodGet {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
 		return
 	}
 
 	syncInfo, err := c.service.GetLastSyncInfo("gen5")
 	if err != nil {
-		log.Printf("Error getting sync status: %v", err)
-		http.Error(w, "Failed to get sync status", http.StatusInternalServerError)
+		writeError(w, err, "Failed to get sync status")
 		return
 	}
 
@@ -183,4 +314,59 @@ func (c *PokemonController) GetSyncStatus(w http.ResponseWriter, r *http.Request
 	"success": true,
 	"data": syncInfo,
 })
-}
\ No newline at end of file
+}
+// GetPokemonRaw handles GET /api/pokemon/{id}/raw
+func (c *PokemonController) GetPokemonRaw(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	id, ok := c.resolvePokemon(w, r)
+	if !ok {
+		return
+	}
+
+	raw, err := c.service.GetRawPayload(id)
+	if err != nil {
+		writeError(w, err, "Failed to retrieve raw payload")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    raw,
+	})
+}
+
+// Autocomplete handles GET /api/pokemon/autocomplete?q=
+func (c *PokemonController) Autocomplete(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	query := r.URL.Query()
+
+	limit := 10
+	if l := query.Get("limit"); l != "" {
+		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+
+	results, err := c.service.Autocomplete(query.Get("q"), limit)
+	if err != nil {
+		writeError(w, err, "Failed to search pokemon")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    results,
+	})
+}
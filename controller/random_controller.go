@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"pokeAPI/service"
+	"strconv"
+	"time"
+)
+
+// RandomPokemon handles GET /api/pokemon/random?count=&seed=, plus the usual list filters
+func (c *PokemonController) RandomPokemon(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	query := r.URL.Query()
+
+	count := 1
+	if c := query.Get("count"); c != "" {
+		parsed, err := strconv.Atoi(c)
+		if err != nil || parsed <= 0 {
+			writeFieldError(w, "count", "count must be a positive integer")
+			return
+		}
+		count = parsed
+	}
+
+	// The seed is echoed back so a draw can be reproduced
+	seed := query.Get("seed")
+	if seed == "" {
+		seed = service.NewSeed()
+	}
+
+	filter, err := parsePokemonFilter(query)
+	if err != nil {
+		writeError(w, err, "Invalid request")
+		return
+	}
+
+	fields, include, err := parseFieldsets(query)
+	if err != nil {
+		writeError(w, err, "Invalid request")
+		return
+	}
+
+	pokemons, err := c.service.GetRandomPokemon(count, seed, filter, include)
+	if err != nil {
+		writeError(w, err, "Failed to retrieve pokemon")
+		return
+	}
+	for i, pokemon := range pokemons {
+		pokemons[i] = selectFields(pokemon, fields)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    pokemons,
+		"seed":    seed,
+	})
+}
+
+// DailyPokemon handles GET /api/pokemon/daily?date=YYYY-MM-DD&tz=
+// Without a date, "today" is taken in the given IANA timezone (UTC by default).
+func (c *PokemonController) DailyPokemon(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	query := r.URL.Query()
+
+	loc := time.UTC
+	if tz := query.Get("tz"); tz != "" {
+		parsed, err := time.LoadLocation(tz)
+		if err != nil {
+			writeFieldError(w, "tz", "Invalid tz: "+tz)
+			return
+		}
+		loc = parsed
+	}
+
+	date := time.Now().In(loc)
+	if d := query.Get("date"); d != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", d, loc)
+		if err != nil {
+			writeFieldError(w, "date", "date must be formatted as YYYY-MM-DD")
+			return
+		}
+		date = parsed
+	}
+
+	fields, include, err := parseFieldsets(query)
+	if err != nil {
+		writeError(w, err, "Invalid request")
+		return
+	}
+
+	pokemon, err := c.service.GetDailyPokemon(date, c.dailyNoRepeatDays, include)
+	if err != nil {
+		writeError(w, err, "Failed to retrieve pokemon")
+		return
+	}
+
+	// Keep the date even when only some fields were asked for
+	if len(fields) > 0 {
+		fields = append(fields, "date")
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    selectFields(pokemon, fields),
+	})
+}
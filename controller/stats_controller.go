@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"pokeAPI/service"
+	"strconv"
+	"strings"
+)
+
+// GetStatsSummary handles GET /api/stats/summary, taking the same filters as GET /api/pokemon
+func (c *PokemonController) GetStatsSummary(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	filter, err := parsePokemonFilter(r.URL.Query())
+	if err != nil {
+		writeError(w, err, "Invalid request")
+		return
+	}
+
+	summary, err := c.service.GetStatsSummary(filter)
+	if err != nil {
+		writeError(w, err, "Failed to retrieve stats summary")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    summary,
+	})
+}
+
+// GetLeaderboard handles GET /api/leaderboards/{stat}?type=&limit=
+func (c *PokemonController) GetLeaderboard(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	pathParts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	stat := pathParts[len(pathParts)-1]
+	if !contains(service.RankedStats, stat) {
+		writeFieldError(w, "stat", fmt.Sprintf("unknown stat %q, expected one of %s", stat, strings.Join(service.RankedStats, ",")))
+		return
+	}
+
+	query := r.URL.Query()
+
+	limit := 0
+	if l := query.Get("limit"); l != "" {
+		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+
+	entries, err := c.service.GetLeaderboard(stat, query.Get("type"), limit)
+	if err != nil {
+		writeError(w, err, "Failed to retrieve leaderboard")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    entries,
+	})
+}
+
+// GetCacheStats handles GET /api/cache/stats
+func (c *PokemonController) GetCacheStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    c.service.ReadCacheStats(),
+	})
+}
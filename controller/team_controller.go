@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"pokeAPI/service"
+	"strings"
+)
+
+// AnalyzeTeam handles POST /api/teams/analyze with {"ids": [635, "zoroark", ...]}
+func (c *PokemonController) AnalyzeTeam(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	var body struct {
+		IDs []interface{} `json:"ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeErrorStatus(w, http.StatusBadRequest, "Request body must be a JSON object with an ids array")
+		return
+	}
+
+	idsOrNames, err := jsonIDs(body.IDs)
+	if err != nil {
+		writeError(w, err, "Invalid request")
+		return
+	}
+	if len(idsOrNames) == 0 || len(idsOrNames) > service.MaxTeamSize {
+		writeFieldError(w, "ids", fmt.Sprintf("A team holds 1 to %d Pokemon", service.MaxTeamSize))
+		return
+	}
+
+	analysis, missing, err := c.service.AnalyzeTeam(idsOrNames)
+	if err != nil {
+		writeError(w, err, "Failed to analyze team")
+		return
+	}
+	if len(missing) > 0 {
+		writeErrorStatus(w, http.StatusNotFound, "Pokemon not found: "+strings.Join(missing, ", "))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    analysis,
+	})
+}
@@ -1,5 +1,10 @@
 package dto
 
+import (
+	"encoding/json"
+	"time"
+)
+
 // PokeAPIResponse represents the Pokemon data from PokeAPI
 type PokeAPIResponse struct {
 	ID             int              `json:"id"`
@@ -10,6 +15,10 @@ type PokeAPIResponse struct {
 	Types          []TypeSlot       `json:"types"`
 	Abilities      []AbilitySlot    `json:"abilities"`
 	Stats          []StatDetail     `json:"stats"`
+
+	// Raw is the untouched upstream payload, kept so fields we don't decode yet can be derived later
+	Raw       json.RawMessage `json:"-"`
+	FetchedAt time.Time       `json:"-"`
 }
 
 // Sprites contains Pokemon sprite URLs
@@ -3,3 +3,16 @@ module pokeAPI
 go 1.25.5
 
 require github.com/lib/pq v1.10.9
+
+require (
+	github.com/graphql-go/graphql v0.8.1
+	google.golang.org/grpc v1.84.0
+	google.golang.org/protobuf v1.36.11
+)
+
+require (
+	golang.org/x/net v0.57.0 // indirect
+	golang.org/x/sys v0.47.0 // indirect
+	golang.org/x/text v0.40.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260706201446-f0a921348800 // indirect
+)
@@ -0,0 +1,142 @@
+package graph
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"pokeAPI/service"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// Options configures the GraphQL endpoint
+type Options struct {
+	MaxDepth      int
+	MaxComplexity int
+	GraphiQL      bool // serve the GraphiQL IDE to browsers, dev mode only
+}
+
+// Handler serves GraphQL queries over HTTP
+type Handler struct {
+	schema  graphql.Schema
+	service *service.PokemonService
+	opts    Options
+}
+
+// NewHandler creates a new GraphQL handler over the Pokemon service
+func NewHandler(svc *service.PokemonService, opts Options) (*Handler, error) {
+	schema, err := newSchema(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Handler{
+		schema:  schema,
+		service: svc,
+		opts:    opts,
+	}, nil
+}
+
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+// ServeGraphQL handles GET and POST /graphql
+func (h *Handler) ServeGraphQL(w http.ResponseWriter, r *http.Request) {
+	var req graphQLRequest
+
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		if query.Get("query") == "" && h.opts.GraphiQL && strings.Contains(r.Header.Get("Accept"), "text/html") {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.Write([]byte(graphiQLPage))
+			return
+		}
+		req.Query = query.Get("query")
+		req.OperationName = query.Get("operationName")
+		if v := query.Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				writeErrors(w, http.StatusBadRequest, "variables must be a JSON object")
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeErrors(w, http.StatusBadRequest, "request body must be a JSON object with a query")
+			return
+		}
+	default:
+		writeErrors(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	if req.Query == "" {
+		writeErrors(w, http.StatusBadRequest, "query is required")
+		return
+	}
+
+	// Enforce depth and complexity limits before anything is resolved
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, gqlerrors.FormatError(err).Message)
+		return
+	}
+	if err := checkLimits(doc, req.Variables, h.opts.MaxDepth, h.opts.MaxComplexity); err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:         h.schema,
+		RequestString:  req.Query,
+		VariableValues: req.Variables,
+		OperationName:  req.OperationName,
+		Context:        withLoaders(r.Context(), h.service),
+	})
+	if result.HasErrors() {
+		log.Printf("GraphQL errors: %v", result.Errors)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
+
+// writeErrors replies with a GraphQL-shaped error list for requests rejected before execution
+func writeErrors(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"errors": []map[string]string{{"message": message}},
+	})
+}
+
+const graphiQLPage = `<!DOCTYPE html>
+<html>
+<head>
+	<title>GraphiQL - pokemon-api</title>
+	<link rel="stylesheet" href="https://unpkg.com/graphiql@3/graphiql.min.css" />
+</head>
+<body style="margin: 0;">
+	<div id="graphiql" style="height: 100vh;"></div>
+	<script crossorigin src="https://unpkg.com/react@18/umd/react.production.min.js"></script>
+	<script crossorigin src="https://unpkg.com/react-dom@18/umd/react-dom.production.min.js"></script>
+	<script crossorigin src="https://unpkg.com/graphiql@3/graphiql.min.js"></script>
+	<script>
+		const fetcher = GraphiQL.createFetcher({ url: window.location.pathname });
+		ReactDOM.createRoot(document.getElementById('graphiql')).render(
+			React.createElement(GraphiQL, { fetcher: fetcher })
+		);
+	</script>
+</body>
+</html>
+`
@@ -0,0 +1,135 @@
+package graph
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// defaultListLimit is the page size assumed when a list field is queried without a limit argument
+const defaultListLimit = 20
+
+// checkLimits rejects documents nested deeper than maxDepth or costing more than maxComplexity.
+// Every field costs 1, and a field taking a limit argument multiplies the cost of its selection by that limit.
+func checkLimits(doc *ast.Document, variables map[string]interface{}, maxDepth, maxComplexity int) error {
+	fragments := map[string]*ast.FragmentDefinition{}
+	for _, def := range doc.Definitions {
+		if fragment, ok := def.(*ast.FragmentDefinition); ok {
+			fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	w := &limitWalker{fragments: fragments, variables: variables, maxDepth: maxDepth, visiting: map[string]bool{}}
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+
+		complexity, err := w.cost(op.SelectionSet, 1)
+		if err != nil {
+			return err
+		}
+		if complexity > maxComplexity {
+			return fmt.Errorf("query complexity %d exceeds the maximum of %d", complexity, maxComplexity)
+		}
+	}
+
+	return nil
+}
+
+type limitWalker struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+	maxDepth  int
+	visiting  map[string]bool // fragments on the current path, so cycles can't recurse forever
+	// introspecting is set inside __schema and __type, whose depth is bounded by the schema
+	// itself and which GraphiQL's introspection query nests deeper than the default limit
+	introspecting bool
+}
+
+// cost walks a selection set at the given depth, following fragments, and returns its complexity
+func (w *limitWalker) cost(set *ast.SelectionSet, depth int) (int, error) {
+	if set == nil {
+		return 0, nil
+	}
+	if depth > w.maxDepth && !w.introspecting {
+		return 0, fmt.Errorf("query depth exceeds the maximum of %d", w.maxDepth)
+	}
+
+	total := 0
+	for _, selection := range set.Selections {
+		switch sel := selection.(type) {
+		case *ast.Field:
+			introspection := !w.introspecting && (sel.Name.Value == "__schema" || sel.Name.Value == "__type")
+			if introspection {
+				w.introspecting = true
+			}
+			children, err := w.cost(sel.SelectionSet, depth+1)
+			if introspection {
+				w.introspecting = false
+			}
+			if err != nil {
+				return 0, err
+			}
+			total += 1 + w.multiplier(sel)*children
+		case *ast.InlineFragment:
+			children, err := w.cost(sel.SelectionSet, depth)
+			if err != nil {
+				return 0, err
+			}
+			total += children
+		case *ast.FragmentSpread:
+			fragment, ok := w.fragments[sel.Name.Value]
+			if !ok {
+				continue // reported by validation
+			}
+			// Rejected here rather than in validation, which recurses on cycles until the stack runs out
+			if w.visiting[sel.Name.Value] {
+				return 0, fmt.Errorf("fragment cycle through %q", sel.Name.Value)
+			}
+			w.visiting[sel.Name.Value] = true
+			children, err := w.cost(fragment.SelectionSet, depth)
+			delete(w.visiting, sel.Name.Value)
+			if err != nil {
+				return 0, err
+			}
+			total += children
+		}
+	}
+
+	return total, nil
+}
+
+// multiplier returns how many times a field's selection is expected to repeat
+func (w *limitWalker) multiplier(field *ast.Field) int {
+	for _, arg := range field.Arguments {
+		if arg.Name.Value != "limit" {
+			continue
+		}
+		switch value := arg.Value.(type) {
+		case *ast.IntValue:
+			if n, err := strconv.Atoi(value.Value); err == nil && n > 0 {
+				return n
+			}
+		case *ast.Variable:
+			switch n := w.variables[value.Name.Value].(type) {
+			case float64:
+				if n > 0 {
+					return int(n)
+				}
+			case int:
+				if n > 0 {
+					return n
+				}
+			}
+		}
+		return defaultListLimit
+	}
+
+	if field.Name.Value == "pokemon_list" {
+		return defaultListLimit
+	}
+	return 1
+}
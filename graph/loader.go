@@ -0,0 +1,86 @@
+package graph
+
+import (
+	"context"
+	"pokeAPI/model"
+	"pokeAPI/service"
+	"sync"
+)
+
+// batchLoader collects keys requested by sibling resolvers and fetches them in one call.
+// Resolvers return the thunk from load; graphql-go runs thunks breadth-first, so by the
+// time the first one runs every Pokemon on the page has queued its key.
+type batchLoader[V any] struct {
+	mu      sync.Mutex
+	fetch   func(keys []int) (map[int]V, error)
+	pending []int
+	queued  map[int]bool
+	results map[int]V
+	errs    map[int]error // keys whose batch failed, so every sibling reports the error
+}
+
+func newBatchLoader[V any](fetch func(keys []int) (map[int]V, error)) *batchLoader[V] {
+	return &batchLoader[V]{
+		fetch:   fetch,
+		queued:  map[int]bool{},
+		results: map[int]V{},
+		errs:    map[int]error{},
+	}
+}
+
+// load queues a key and returns a thunk that resolves it, fetching every queued key on first use
+func (l *batchLoader[V]) load(key int) func() (V, error) {
+	l.mu.Lock()
+	if !l.queued[key] {
+		l.queued[key] = true
+		l.pending = append(l.pending, key)
+	}
+	l.mu.Unlock()
+
+	return func() (V, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if len(l.pending) > 0 {
+			keys := l.pending
+			l.pending = nil
+
+			results, err := l.fetch(keys)
+			for _, k := range keys {
+				if err != nil {
+					l.errs[k] = err
+					continue
+				}
+				l.results[k] = results[k]
+			}
+		}
+
+		if err := l.errs[key]; err != nil {
+			var zero V
+			return zero, err
+		}
+		return l.results[key], nil
+	}
+}
+
+// loaders holds the per-request batch loaders, keyed by Pokedex ID
+type loaders struct {
+	types     *batchLoader[[]model.PokemonType]
+	abilities *batchLoader[[]model.PokemonAbility]
+	stats     *batchLoader[*model.PokemonStats]
+}
+
+type loadersKey struct{}
+
+// withLoaders attaches fresh loaders to a request context; loaders must never be shared between requests
+func withLoaders(ctx context.Context, svc *service.PokemonService) context.Context {
+	return context.WithValue(ctx, loadersKey{}, &loaders{
+		types:     newBatchLoader(svc.TypesByPokedexID),
+		abilities: newBatchLoader(svc.AbilitiesByPokedexID),
+		stats:     newBatchLoader(svc.StatsByPokedexID),
+	})
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
@@ -0,0 +1,289 @@
+package graph
+
+import (
+	"errors"
+	"pokeAPI/model"
+	"pokeAPI/service"
+	"strconv"
+	"time"
+
+	"github.com/graphql-go/graphql"
+)
+
+// Field names follow the REST API's snake_case JSON so both surfaces describe the same data the same way
+
+var pokemonTypeType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "PokemonType",
+	Description: "A Pokemon's type (fire, water, etc.) in slot 1 (primary) or 2 (secondary)",
+	Fields: graphql.Fields{
+		"id":         &graphql.Field{Type: graphql.Int},
+		"pokemon_id": &graphql.Field{Type: graphql.Int},
+		"type_name":  &graphql.Field{Type: graphql.String},
+		"slot":       &graphql.Field{Type: graphql.Int},
+	},
+})
+
+var pokemonAbilityType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "PokemonAbility",
+	Description: "A Pokemon's ability",
+	Fields: graphql.Fields{
+		"id":           &graphql.Field{Type: graphql.Int},
+		"pokemon_id":   &graphql.Field{Type: graphql.Int},
+		"ability_name": &graphql.Field{Type: graphql.String},
+		"is_hidden":    &graphql.Field{Type: graphql.Boolean},
+		"slot":         &graphql.Field{Type: graphql.Int},
+	},
+})
+
+var pokemonStatsType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "PokemonStats",
+	Description: "A Pokemon's base stats",
+	Fields: graphql.Fields{
+		"id":              &graphql.Field{Type: graphql.Int},
+		"pokemon_id":      &graphql.Field{Type: graphql.Int},
+		"hp":              &graphql.Field{Type: graphql.Int},
+		"attack":          &graphql.Field{Type: graphql.Int},
+		"defense":         &graphql.Field{Type: graphql.Int},
+		"special_attack":  &graphql.Field{Type: graphql.Int},
+		"special_defense": &graphql.Field{Type: graphql.Int},
+		"speed":           &graphql.Field{Type: graphql.Int},
+		"base_stat_total": &graphql.Field{
+			Type: graphql.Int,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				st := p.Source.(*model.PokemonStats)
+				return st.HP + st.Attack + st.Defense + st.SpecialAttack + st.SpecialDefense + st.Speed, nil
+			},
+		},
+	},
+})
+
+var pokemonType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "Pokemon",
+	Description: "A Pokemon; id is its Pokedex number",
+	Fields: graphql.Fields{
+		"id":             &graphql.Field{Type: graphql.Int},
+		"name":           &graphql.Field{Type: graphql.String},
+		"height":         &graphql.Field{Type: graphql.Int, Description: "in decimeters"},
+		"weight":         &graphql.Field{Type: graphql.Int, Description: "in hectograms"},
+		"sprite_url":     &graphql.Field{Type: graphql.String},
+		"animated_front": &graphql.Field{Type: graphql.String},
+		"animated_back":  &graphql.Field{Type: graphql.String},
+		"created_at": &graphql.Field{
+			Type: graphql.String,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if pokemon, ok := p.Source.(*model.Pokemon); ok {
+					return pokemon.CreatedAt.Format(time.RFC3339Nano), nil
+				}
+				return p.Source.(map[string]interface{})["created_at"], nil
+			},
+		},
+		"types": &graphql.Field{
+			Type: graphql.NewList(pokemonTypeType),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				thunk := loadersFrom(p.Context).types.load(pokedexIDOf(p.Source))
+				return func() (interface{}, error) { return thunk() }, nil
+			},
+		},
+		"abilities": &graphql.Field{
+			Type: graphql.NewList(pokemonAbilityType),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				thunk := loadersFrom(p.Context).abilities.load(pokedexIDOf(p.Source))
+				return func() (interface{}, error) { return thunk() }, nil
+			},
+		},
+		"stats": &graphql.Field{
+			Type: pokemonStatsType,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				thunk := loadersFrom(p.Context).stats.load(pokedexIDOf(p.Source))
+				return func() (interface{}, error) { return thunk() }, nil
+			},
+		},
+	},
+})
+
+var pokemonPageType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "PokemonPage",
+	Description: "One page of Pokemon. Offset pages carry totals, cursor pages only cursors",
+	Fields: graphql.Fields{
+		"data":         &graphql.Field{Type: graphql.NewList(pokemonType)},
+		"total":        &graphql.Field{Type: graphql.Int},
+		"page":         &graphql.Field{Type: graphql.Int},
+		"limit":        &graphql.Field{Type: graphql.Int},
+		"total_pages":  &graphql.Field{Type: graphql.Int},
+		"has_next":     &graphql.Field{Type: graphql.Boolean},
+		"has_previous": &graphql.Field{Type: graphql.Boolean},
+		"next_cursor":  &graphql.Field{Type: graphql.String},
+		"prev_cursor":  &graphql.Field{Type: graphql.String},
+	},
+})
+
+var syncStatusType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "SyncStatus",
+	Description: "When a sync last ran and how many Pokemon it saved",
+	Fields: graphql.Fields{
+		"sync_type":    &graphql.Field{Type: graphql.String},
+		"last_sync_at": &graphql.Field{Type: graphql.String},
+		"total_synced": &graphql.Field{Type: graphql.Int},
+		"status":       &graphql.Field{Type: graphql.String},
+	},
+})
+
+// statBoundsType has one optional Int per field in service.RangeFilterFields
+var statBoundsType = func() *graphql.InputObject {
+	fields := graphql.InputObjectConfigFieldMap{}
+	for field := range service.RangeFilterFields {
+		fields[field] = &graphql.InputObjectFieldConfig{Type: graphql.Int}
+	}
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        "StatBounds",
+		Description: "Inclusive bounds on height, weight, base stats and base_stat_total",
+		Fields:      fields,
+	})
+}()
+
+// newSchema builds the schema; resolvers read the service from the closure and loaders from the request context
+func newSchema(svc *service.PokemonService) (graphql.Schema, error) {
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"pokemon": &graphql.Field{
+				Type:        pokemonType,
+				Description: "A single Pokemon by Pokedex number or name",
+				Args: graphql.FieldConfigArgument{
+					"id":   &graphql.ArgumentConfig{Type: graphql.Int},
+					"name": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					idOrName, _ := p.Args["name"].(string)
+					if id, ok := p.Args["id"].(int); ok {
+						idOrName = strconv.Itoa(id)
+					}
+
+					id, _, err := svc.ResolvePokemon(idOrName)
+					if err == nil {
+						var pokemon *model.Pokemon
+						if pokemon, err = svc.GetPokemonByID(id); err == nil {
+							return pokemon, nil
+						}
+					}
+					if errors.Is(err, service.ErrNotFound) {
+						return nil, nil
+					}
+					return nil, err
+				},
+			},
+			"pokemon_list": &graphql.Field{
+				Type:        pokemonPageType,
+				Description: "Filtered, sorted and paginated Pokemon, mirroring GET /api/pokemon",
+				Args: graphql.FieldConfigArgument{
+					"limit":          &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultListLimit},
+					"offset":         &graphql.ArgumentConfig{Type: graphql.Int},
+					"page":           &graphql.ArgumentConfig{Type: graphql.Int},
+					"sort":           &graphql.ArgumentConfig{Type: graphql.String},
+					"order":          &graphql.ArgumentConfig{Type: graphql.String},
+					"cursor":         &graphql.ArgumentConfig{Type: graphql.String},
+					"q":              &graphql.ArgumentConfig{Type: graphql.String},
+					"type":           &graphql.ArgumentConfig{Type: graphql.NewList(graphql.String)},
+					"type_match":     &graphql.ArgumentConfig{Type: graphql.String},
+					"types_exact":    &graphql.ArgumentConfig{Type: graphql.NewList(graphql.String)},
+					"ability":        &graphql.ArgumentConfig{Type: graphql.String},
+					"hidden_ability": &graphql.ArgumentConfig{Type: graphql.String},
+					"generation":     &graphql.ArgumentConfig{Type: graphql.Int},
+					"min":            &graphql.ArgumentConfig{Type: statBoundsType},
+					"max":            &graphql.ArgumentConfig{Type: statBoundsType},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return resolvePokemonList(svc, p.Args)
+				},
+			},
+			"sync_status": &graphql.Field{
+				Type: syncStatusType,
+				Args: graphql.FieldConfigArgument{
+					"sync_type": &graphql.ArgumentConfig{Type: graphql.String, DefaultValue: "gen5"},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return svc.GetLastSyncInfo(p.Args["sync_type"].(string))
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+// resolvePokemonList maps pokemon_list arguments onto the same service calls GET /api/pokemon makes
+func resolvePokemonList(svc *service.PokemonService, args map[string]interface{}) (interface{}, error) {
+	limit, _ := args["limit"].(int)
+	offset, _ := args["offset"].(int)
+	if page, ok := args["page"].(int); ok && page > 0 {
+		offset = (page - 1) * limit
+	}
+
+	filter := service.PokemonFilter{
+		Types:      stringList(args["type"]),
+		TypeMatch:  service.TypeMatchAny,
+		TypesExact: stringList(args["types_exact"]),
+		Min:        intMap(args["min"]),
+		Max:        intMap(args["max"]),
+	}
+	filter.Query, _ = args["q"].(string)
+	filter.Ability, _ = args["ability"].(string)
+	filter.HiddenAbility, _ = args["hidden_ability"].(string)
+	filter.Generation, _ = args["generation"].(int)
+	if match, ok := args["type_match"].(string); ok {
+		filter.TypeMatch = match
+	}
+
+	if cursor, ok := args["cursor"].(string); ok && cursor != "" {
+		return svc.GetPokemonByCursor(limit, cursor, filter, nil)
+	}
+
+	// Searches rank by relevance unless a sort is given
+	sortBy, _ := args["sort"].(string)
+	if sortBy == "" {
+		sortBy = "pokedex_id"
+		if filter.Query != "" {
+			sortBy = service.SortRelevance
+		}
+	}
+	order, _ := args["order"].(string)
+	if order == "" {
+		order = "asc"
+		if sortBy == service.SortRelevance {
+			order = "desc"
+		}
+	}
+
+	return svc.GetPokemonPaginated(limit, offset, sortBy, order, filter, nil)
+}
+
+// pokedexIDOf returns the Pokedex number of a Pokemon resolved either as a model or a list row
+func pokedexIDOf(source interface{}) int {
+	if pokemon, ok := source.(*model.Pokemon); ok {
+		return pokemon.ID
+	}
+	id, _ := source.(map[string]interface{})["id"].(int)
+	return id
+}
+
+func stringList(value interface{}) []string {
+	items, _ := value.([]interface{})
+	var out []string
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func intMap(value interface{}) map[string]int {
+	fields, _ := value.(map[string]interface{})
+	out := make(map[string]int, len(fields))
+	for k, v := range fields {
+		if n, ok := v.(int); ok {
+			out[k] = n
+		}
+	}
+	return out
+}
@@ -0,0 +1,286 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"log"
+	"pokeAPI/proto/pokemonpb"
+	"pokeAPI/service"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Server implements pokemonpb.PokemonServiceServer over the existing PokemonService
+type Server struct {
+	pokemonpb.UnimplementedPokemonServiceServer
+	service *service.PokemonService
+}
+
+// NewServer creates a new gRPC Pokemon server
+func NewServer(svc *service.PokemonService) *Server {
+	return &Server{service: svc}
+}
+
+// NewGRPCServer returns a gRPC server with the Pokemon, health and reflection services registered.
+// The caller serves it on a listener and stops it on shutdown.
+func NewGRPCServer(svc *service.PokemonService) *grpc.Server {
+	grpcServer := grpc.NewServer()
+	pokemonpb.RegisterPokemonServiceServer(grpcServer, NewServer(svc))
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(pokemonpb.PokemonService_ServiceDesc.ServiceName, grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+
+	reflection.Register(grpcServer)
+
+	return grpcServer
+}
+
+// ListPokemon mirrors GET /api/pokemon
+func (s *Server) ListPokemon(ctx context.Context, req *pokemonpb.ListPokemonRequest) (*pokemonpb.ListPokemonResponse, error) {
+	filter := service.PokemonFilter{
+		Query:         req.GetQ(),
+		Types:         req.GetTypes(),
+		TypeMatch:     service.TypeMatchAny,
+		TypesExact:    req.GetTypesExact(),
+		Ability:       req.GetAbility(),
+		HiddenAbility: req.GetHiddenAbility(),
+		Min:           intMap(req.GetMin()),
+		Max:           intMap(req.GetMax()),
+	}
+	if match := req.GetTypeMatch(); match != "" {
+		if match != service.TypeMatchAny && match != service.TypeMatchAll {
+			return nil, status.Errorf(codes.InvalidArgument, "type_match must be %q or %q", service.TypeMatchAny, service.TypeMatchAll)
+		}
+		filter.TypeMatch = match
+	}
+
+	include := []string{service.IncludeAbilities, service.IncludeStats}
+
+	var result map[string]interface{}
+	var err error
+	if req.GetCursor() != "" {
+		result, err = s.service.GetPokemonByCursor(int(req.GetLimit()), req.GetCursor(), filter, include)
+	} else {
+		// Searches rank by relevance unless a sort is given
+		sortBy, order := req.GetSort(), req.GetOrder()
+		if sortBy == "" {
+			sortBy = "pokedex_id"
+			if filter.Query != "" {
+				sortBy = service.SortRelevance
+			}
+		}
+		if order == "" {
+			order = "asc"
+			if sortBy == service.SortRelevance {
+				order = "desc"
+			}
+		}
+		result, err = s.service.GetPokemonPaginated(int(req.GetLimit()), int(req.GetOffset()), sortBy, order, filter, include)
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pokemonpb.ListPokemonResponse{
+		Limit:       int32(intValue(result["limit"])),
+		Total:       int32(intValue(result["total"])),
+		Page:        int32(intValue(result["page"])),
+		TotalPages:  int32(intValue(result["total_pages"])),
+		HasNext:     result["has_next"] == true,
+		HasPrevious: result["has_previous"] == true,
+	}
+	resp.NextCursor, _ = result["next_cursor"].(string)
+	resp.PrevCursor, _ = result["prev_cursor"].(string)
+
+	pokemons, _ := result["data"].([]map[string]interface{})
+	for _, pokemon := range pokemons {
+		resp.Pokemon = append(resp.Pokemon, toPokemon(pokemon))
+	}
+
+	return resp, nil
+}
+
+// GetPokemon returns one Pokemon by Pokedex number or name
+func (s *Server) GetPokemon(ctx context.Context, req *pokemonpb.GetPokemonRequest) (*pokemonpb.Pokemon, error) {
+	idOrName := req.GetName()
+	if _, ok := req.GetLookup().(*pokemonpb.GetPokemonRequest_Id); ok {
+		idOrName = strconv.Itoa(int(req.GetId()))
+	}
+	if idOrName == "" {
+		return nil, status.Error(codes.InvalidArgument, "id or name is required")
+	}
+
+	id, _, err := s.service.ResolvePokemon(idOrName)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	pokemon, err := s.service.GetPokemonDetail(id, []string{service.IncludeAbilities, service.IncludeStats})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toPokemon(pokemon), nil
+}
+
+// StartSync starts a Gen 5 sync, or returns the one already running
+func (s *Server) StartSync(ctx context.Context, req *pokemonpb.StartSyncRequest) (*pokemonpb.SyncJob, error) {
+	job, err := s.service.StartSyncJob()
+	switch {
+	case err == nil:
+		log.Printf("Starting Gen 5 Pokemon sync via gRPC (job %s)...", job.ID)
+	case !errors.Is(err, service.ErrConflict):
+		return nil, toStatus(err)
+	}
+	return toSyncJob(job), nil
+}
+
+// WatchSyncJob streams a sync job's progress until it finishes
+func (s *Server) WatchSyncJob(req *pokemonpb.WatchSyncJobRequest, stream grpc.ServerStreamingServer[pokemonpb.SyncJob]) error {
+	err := s.service.WatchSyncJob(stream.Context(), req.GetJobId(), func(job service.SyncJob) error {
+		return stream.Send(toSyncJob(job))
+	})
+	if err != nil {
+		return toStatus(err)
+	}
+	return nil
+}
+
+// GetSyncStatus returns when a sync last completed
+func (s *Server) GetSyncStatus(ctx context.Context, req *pokemonpb.GetSyncStatusRequest) (*pokemonpb.SyncStatus, error) {
+	syncType := req.GetSyncType()
+	if syncType == "" {
+		syncType = "gen5"
+	}
+
+	info, err := s.service.GetLastSyncInfo(syncType)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pokemonpb.SyncStatus{
+		SyncType:    syncType,
+		TotalSynced: int32(intValue(info["total_synced"])),
+	}
+	resp.Status, _ = info["status"].(string)
+	if lastSyncAt, ok := info["last_sync_at"].(string); ok {
+		resp.LastSyncAt = timestamp(lastSyncAt)
+	}
+
+	return resp, nil
+}
+
+// toStatus maps service errors onto gRPC status codes
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	case errors.Is(err, service.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrConflict):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrUpstream):
+		log.Printf("gRPC upstream error: %v", err)
+		return status.Error(codes.Unavailable, "upstream unavailable")
+	}
+	log.Printf("gRPC error: %v", err)
+	return status.Error(codes.Internal, "internal error")
+}
+
+// toPokemon converts a list or detail row, loaded with abilities and stats, to its protobuf message
+func toPokemon(row map[string]interface{}) *pokemonpb.Pokemon {
+	pokemon := &pokemonpb.Pokemon{
+		Id:     int32(intValue(row["id"])),
+		Height: int32(intValue(row["height"])),
+		Weight: int32(intValue(row["weight"])),
+	}
+	pokemon.Name, _ = row["name"].(string)
+	pokemon.SpriteUrl, _ = row["sprite_url"].(string)
+	pokemon.AnimatedFront, _ = row["animated_front"].(string)
+	pokemon.AnimatedBack, _ = row["animated_back"].(string)
+	pokemon.Types, _ = row["types"].([]string)
+	if createdAt, ok := row["created_at"].(string); ok {
+		pokemon.CreatedAt = timestamp(createdAt)
+	}
+
+	abilities, _ := row[service.IncludeAbilities].([]map[string]interface{})
+	for _, a := range abilities {
+		ability := &pokemonpb.Ability{Slot: int32(intValue(a["slot"]))}
+		ability.Name, _ = a["name"].(string)
+		ability.IsHidden, _ = a["is_hidden"].(bool)
+		pokemon.Abilities = append(pokemon.Abilities, ability)
+	}
+
+	if stats, ok := row[service.IncludeStats].(map[string]interface{}); ok {
+		pokemon.Stats = &pokemonpb.Stats{
+			Hp:             int32(intValue(stats["hp"])),
+			Attack:         int32(intValue(stats["attack"])),
+			Defense:        int32(intValue(stats["defense"])),
+			SpecialAttack:  int32(intValue(stats["special_attack"])),
+			SpecialDefense: int32(intValue(stats["special_defense"])),
+			Speed:          int32(intValue(stats["speed"])),
+			BaseStatTotal:  int32(intValue(stats["base_stat_total"])),
+		}
+	}
+
+	return pokemon
+}
+
+func toSyncJob(job service.SyncJob) *pokemonpb.SyncJob {
+	state := pokemonpb.SyncJob_STATE_RUNNING
+	switch job.Status {
+	case service.SyncJobCompleted:
+		state = pokemonpb.SyncJob_STATE_COMPLETED
+	case service.SyncJobFailed:
+		state = pokemonpb.SyncJob_STATE_FAILED
+	}
+
+	msg := &pokemonpb.SyncJob{
+		Id:        job.ID,
+		State:     state,
+		Total:     int32(job.Total),
+		Processed: int32(job.Processed),
+		Saved:     int32(job.Saved),
+		Failed:    int32(job.Failed),
+		Current:   job.Current,
+		StartedAt: timestamppb.New(job.StartedAt),
+		Error:     job.Error,
+	}
+	if job.FinishedAt != nil {
+		msg.FinishedAt = timestamppb.New(*job.FinishedAt)
+	}
+	return msg
+}
+
+// timestamp parses a timestamp the service returned as text, leaving it unset if it can't be parsed
+func timestamp(value string) *timestamppb.Timestamp {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func intValue(value interface{}) int {
+	n, _ := value.(int)
+	return n
+}
+
+func intMap(values map[string]int32) map[string]int {
+	out := make(map[string]int, len(values))
+	for k, v := range values {
+		out[k] = int(v)
+	}
+	return out
+}
@@ -1,11 +1,23 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
 	"pokeAPI/config"
 	"pokeAPI/controller"
+	"pokeAPI/graph"
+	"pokeAPI/grpcserver"
 	"pokeAPI/service"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
 )
 
 // CORS Middleware
@@ -13,7 +25,8 @@ func enableCORS(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request){
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Request-ID")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Request-ID")
 
 		// Handle preflight OPTIONS request
 		if r.Method == "OPTIONS"{
@@ -50,30 +63,155 @@ func main() {
 	log.Println(" Migrations completed")
 
 	// 4. Initialize services
-	pokemonService := service.NewPokemonService(db)
+	pokemonService := service.NewPokemonService(db, cfg)
+
+	// Backfill mode: rebuild columns from stored payloads and exit
+	if len(os.Args) > 1 && os.Args[1] == "backfill" {
+		if _, err := pokemonService.BackfillFromRawPayloads(); err != nil {
+			log.Fatalf("Backfill failed: %v", err)
+		}
+		return
+	}
+
+	// Other replicas tell us when their syncs change the data
+	if err := pokemonService.ListenForInvalidations(cfg.ConnectionString()); err != nil {
+		log.Printf("Warning: cache invalidations from other replicas won't be received: %v", err)
+	}
 
 	// 5. Initialize controllers
-	pokemonController := controller.NewPokemonController(pokemonService)
+	pokemonController := controller.NewPokemonController(pokemonService, cfg)
+
+	graphqlHandler, err := graph.NewHandler(pokemonService, graph.Options{
+		MaxDepth:      cfg.GraphQLMaxDepth,
+		MaxComplexity: cfg.GraphQLMaxComplexity,
+		GraphiQL:      cfg.IsDevelopment(),
+	})
+	if err != nil {
+		log.Fatalf("Failed to build GraphQL schema: %v", err)
+	}
 
 	// 6. Setup routes
 	http.HandleFunc("/health", enableCORS(controller.HealthCheck))
-	http.HandleFunc("/api/pokemon", enableCORS(pokemonController.GetAllPokemon))
-	http.HandleFunc("/api/pokemon/", enableCORS(pokemonController.GetPokemonByID))
+	http.HandleFunc("/api/pokemon", enableCORS(pokemonController.Conditional(cfg.CacheControlList, pokemonController.GetAllPokemon)))
+	http.HandleFunc("/api/pokemon/", enableCORS(pokemonController.Conditional(cfg.CacheControlDetail, pokemonController.GetPokemonByID)))
+	http.HandleFunc("/api/pokemon/{id}/raw", enableCORS(pokemonController.Conditional(cfg.CacheControlDetail, pokemonController.GetPokemonRaw)))
+	http.HandleFunc("/api/pokemon/autocomplete", enableCORS(pokemonController.Autocomplete))
+	http.HandleFunc("/api/pokemon/batch", enableCORS(pokemonController.BatchPokemon))
+	http.HandleFunc("/api/pokemon/random", enableCORS(pokemonController.RandomPokemon))
+	http.HandleFunc("/api/pokemon/daily", enableCORS(pokemonController.DailyPokemon))
+	http.HandleFunc("/api/pokemon/compare", enableCORS(pokemonController.ComparePokemon))
+	http.HandleFunc("/api/teams/analyze", enableCORS(pokemonController.AnalyzeTeam))
+	http.HandleFunc("/api/calc/damage", enableCORS(pokemonController.CalculateDamage))
+	http.HandleFunc("/api/calc/hidden-power", enableCORS(pokemonController.HiddenPower))
+	http.HandleFunc("/api/natures", enableCORS(pokemonController.GetNatures))
+	http.HandleFunc("/api/stats/summary", enableCORS(pokemonController.GetStatsSummary))
+	http.HandleFunc("/api/leaderboards/{stat}", enableCORS(pokemonController.GetLeaderboard))
+	http.HandleFunc("/api/export", enableCORS(pokemonController.ExportPokemon))
+	http.HandleFunc("/api/cache/stats", enableCORS(pokemonController.GetCacheStats))
+	http.HandleFunc("/api/pokemon/{id}/stats/calculate", enableCORS(pokemonController.CalculateStats))
+	http.HandleFunc("/api/pokemon/{id}/ivs", enableCORS(pokemonController.CalculateIVs))
+	http.HandleFunc("/api/pokemon/{id}/similar", enableCORS(pokemonController.SimilarPokemon))
+	http.HandleFunc("/api/v2/pokemon", enableCORS(pokemonController.Conditional(cfg.CacheControlList, pokemonController.MirrorPokemonList)))
+	http.HandleFunc("/api/v2/pokemon/", enableCORS(pokemonController.Conditional(cfg.CacheControlDetail, pokemonController.MirrorPokemon)))
+	http.HandleFunc("/graphql", enableCORS(graphqlHandler.ServeGraphQL))
 	http.HandleFunc("/api/pokemon/sync", enableCORS(pokemonController.SyncGen5Pokemon))
 	http.HandleFunc("/api/pokemon/sync/status", enableCORS(pokemonController.GetSyncStatus))
 
 
-	// 7. Start server
+	// 7. Start gRPC server alongside REST. Either server failing is reported on serverErrs.
+	serverErrs := make(chan error, 2)
+	grpcAddr := ":" + cfg.GRPCPort
+	grpcListener, err := net.Listen("tcp", grpcAddr)
+	if err != nil {
+		log.Fatalf("gRPC server failed to start: %v", err)
+	}
+	grpcServer := grpcserver.NewGRPCServer(pokemonService)
+	go func() {
+		// Serve returns nil, or ErrServerStopped if shutdown beat it, once the server is stopped
+		if err := grpcServer.Serve(grpcListener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			serverErrs <- fmt.Errorf("gRPC server failed: %w", err)
+		}
+	}()
+	log.Printf(" gRPC server starting on localhost%s (health and reflection enabled)", grpcAddr)
+
+	// 8. Start server
 	serverAddr := ":" + cfg.ServerPort
 	log.Printf(" Server starting on http://localhost%s", serverAddr)
 	log.Println(" Available endpoints:")
 	log.Println("   GET  /health              		- Health check")
 	log.Println("   GET  /api/pokemon         		- List all Pokemon")
-	log.Println("   GET  /api/pokemon/{id}    		- Get Pokemon by Pokedex ID")
+	log.Println("   GET  /api/pokemon/{id}    		- Get Pokemon by Pokedex ID or name")
+	log.Println("   GET  /api/pokemon/{id}/raw		- Get raw PokeAPI payload")
+	log.Println("   GET  /api/pokemon/autocomplete	- Name type-ahead (?q=)")
+	log.Println("   POST /api/pokemon/batch   		- Full details for many IDs or names")
+	log.Println("   GET  /api/pokemon/random  		- Random Pokemon (?count=&seed=, list filters)")
+	log.Println("   GET  /api/pokemon/daily   		- Pokemon of the day (?date=&tz=)")
+	log.Println("   GET  /api/pokemon/compare 		- Compare 2 to 6 Pokemon side by side (?ids=)")
+	log.Println("   POST /api/teams/analyze   		- Team type coverage and suggestions")
+	log.Println("   POST /api/calc/damage     		- Generation 5 damage calculator")
+	log.Println("   GET  /api/calc/hidden-power		- Hidden Power type and power (?ivs=), or spreads for a type (?type=&pokemon=)")
+	log.Println("   GET  /api/natures         		- Natures and their stat modifiers")
+	log.Println("   GET  /api/stats/summary   		- Aggregate statistics (list filters apply)")
+	log.Println("   GET  /api/leaderboards/{stat}		- Pokemon ranked by a base stat (?type=&limit=)")
+	log.Println("   GET  /api/export          		- Download as CSV, NDJSON or JSON (list filters apply)")
+	log.Println("   GET  /api/cache/stats     		- Read cache size and hit/miss counters")
+	log.Println("   GET  /api/pokemon/{id}/stats/calculate	- Actual stats for a level, nature, IVs and EVs")
+	log.Println("   POST /api/pokemon/{id}/ivs		- IV ranges from observed stats")
+	log.Println("   GET  /api/pokemon/{id}/similar	- Pokemon with a similar stat profile")
+	log.Println("   GET  /api/v2/pokemon[/{id}]		- PokeAPI-compatible mirror")
+	log.Println("   POST /graphql             		- GraphQL endpoint (GraphiQL on GET in development)")
 	log.Println("   POST /api/pokemon/sync    		- Sync Gen 5 Pokemon from PokeAPI")
 	log.Println("	GET /api/pokemon/sync/status	- Get last sync information")
 	
-	if err := http.ListenAndServe(serverAddr, nil); err != nil {
-		log.Fatalf("Server failed to start: %v", err)
+	server := &http.Server{Addr: serverAddr, Handler: controller.RequestID(http.DefaultServeMux)}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serverErrs <- fmt.Errorf("server failed: %w", err)
+		}
+	}()
+
+	// 9. Run until a signal or a server failure, then stop both servers
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	var serveErr error
+	select {
+	case sig := <-signals:
+		log.Printf(" Received %s, shutting down", sig)
+	case serveErr = <-serverErrs:
+		log.Printf(" %v, shutting down", serveErr)
 	}
-}
\ No newline at end of file
+
+	if err := shutdown(server, grpcServer); err != nil {
+		log.Printf("Shutdown: %v", err)
+	}
+	if serveErr != nil {
+		db.Close()
+		os.Exit(1)
+	}
+	log.Println(" Server stopped")
+}
+
+// shutdownTimeout bounds how long in-flight requests and streams get to finish
+const shutdownTimeout = 15 * time.Second
+
+// shutdown drains the HTTP and gRPC servers together. gRPC streams still open when the
+// timeout runs out, such as a WatchSyncJob on a long sync, are cut off.
+func shutdown(server *http.Server, grpcServer *grpc.Server) error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+
+	err := server.Shutdown(ctx)
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		grpcServer.Stop()
+	}
+	return err
+}
@@ -0,0 +1,1189 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: pokemon.proto
+
+package pokemonpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SyncJob_State int32
+
+const (
+	SyncJob_STATE_UNSPECIFIED SyncJob_State = 0
+	SyncJob_STATE_RUNNING     SyncJob_State = 1
+	SyncJob_STATE_COMPLETED   SyncJob_State = 2
+	SyncJob_STATE_FAILED      SyncJob_State = 3
+)
+
+// Enum value maps for SyncJob_State.
+var (
+	SyncJob_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "STATE_RUNNING",
+		2: "STATE_COMPLETED",
+		3: "STATE_FAILED",
+	}
+	SyncJob_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"STATE_RUNNING":     1,
+		"STATE_COMPLETED":   2,
+		"STATE_FAILED":      3,
+	}
+)
+
+func (x SyncJob_State) Enum() *SyncJob_State {
+	p := new(SyncJob_State)
+	*p = x
+	return p
+}
+
+func (x SyncJob_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SyncJob_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_pokemon_proto_enumTypes[0].Descriptor()
+}
+
+func (SyncJob_State) Type() protoreflect.EnumType {
+	return &file_pokemon_proto_enumTypes[0]
+}
+
+func (x SyncJob_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SyncJob_State.Descriptor instead.
+func (SyncJob_State) EnumDescriptor() ([]byte, []int) {
+	return file_pokemon_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type Pokemon struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Pokedex number
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Height        int32                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"` // in decimeters
+	Weight        int32                  `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"` // in hectograms
+	SpriteUrl     string                 `protobuf:"bytes,5,opt,name=sprite_url,json=spriteUrl,proto3" json:"sprite_url,omitempty"`
+	AnimatedFront string                 `protobuf:"bytes,6,opt,name=animated_front,json=animatedFront,proto3" json:"animated_front,omitempty"`
+	AnimatedBack  string                 `protobuf:"bytes,7,opt,name=animated_back,json=animatedBack,proto3" json:"animated_back,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Types         []string               `protobuf:"bytes,9,rep,name=types,proto3" json:"types,omitempty"` // ordered by slot
+	Abilities     []*Ability             `protobuf:"bytes,10,rep,name=abilities,proto3" json:"abilities,omitempty"`
+	Stats         *Stats                 `protobuf:"bytes,11,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pokemon) Reset() {
+	*x = Pokemon{}
+	mi := &file_pokemon_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pokemon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pokemon) ProtoMessage() {}
+
+func (x *Pokemon) ProtoReflect() protoreflect.Message {
+	mi := &file_pokemon_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pokemon.ProtoReflect.Descriptor instead.
+func (*Pokemon) Descriptor() ([]byte, []int) {
+	return file_pokemon_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Pokemon) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Pokemon) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pokemon) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pokemon) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Pokemon) GetSpriteUrl() string {
+	if x != nil {
+		return x.SpriteUrl
+	}
+	return ""
+}
+
+func (x *Pokemon) GetAnimatedFront() string {
+	if x != nil {
+		return x.AnimatedFront
+	}
+	return ""
+}
+
+func (x *Pokemon) GetAnimatedBack() string {
+	if x != nil {
+		return x.AnimatedBack
+	}
+	return ""
+}
+
+func (x *Pokemon) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Pokemon) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *Pokemon) GetAbilities() []*Ability {
+	if x != nil {
+		return x.Abilities
+	}
+	return nil
+}
+
+func (x *Pokemon) GetStats() *Stats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type Ability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsHidden      bool                   `protobuf:"varint,2,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	Slot          int32                  `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ability) Reset() {
+	*x = Ability{}
+	mi := &file_pokemon_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ability) ProtoMessage() {}
+
+func (x *Ability) ProtoReflect() protoreflect.Message {
+	mi := &file_pokemon_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ability.ProtoReflect.Descriptor instead.
+func (*Ability) Descriptor() ([]byte, []int) {
+	return file_pokemon_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Ability) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Ability) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
+}
+
+func (x *Ability) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+type Stats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Hp             int32                  `protobuf:"varint,1,opt,name=hp,proto3" json:"hp,omitempty"`
+	Attack         int32                  `protobuf:"varint,2,opt,name=attack,proto3" json:"attack,omitempty"`
+	Defense        int32                  `protobuf:"varint,3,opt,name=defense,proto3" json:"defense,omitempty"`
+	SpecialAttack  int32                  `protobuf:"varint,4,opt,name=special_attack,json=specialAttack,proto3" json:"special_attack,omitempty"`
+	SpecialDefense int32                  `protobuf:"varint,5,opt,name=special_defense,json=specialDefense,proto3" json:"special_defense,omitempty"`
+	Speed          int32                  `protobuf:"varint,6,opt,name=speed,proto3" json:"speed,omitempty"`
+	BaseStatTotal  int32                  `protobuf:"varint,7,opt,name=base_stat_total,json=baseStatTotal,proto3" json:"base_stat_total,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Stats) Reset() {
+	*x = Stats{}
+	mi := &file_pokemon_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stats) ProtoMessage() {}
+
+func (x *Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_pokemon_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
+func (*Stats) Descriptor() ([]byte, []int) {
+	return file_pokemon_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Stats) GetHp() int32 {
+	if x != nil {
+		return x.Hp
+	}
+	return 0
+}
+
+func (x *Stats) GetAttack() int32 {
+	if x != nil {
+		return x.Attack
+	}
+	return 0
+}
+
+func (x *Stats) GetDefense() int32 {
+	if x != nil {
+		return x.Defense
+	}
+	return 0
+}
+
+func (x *Stats) GetSpecialAttack() int32 {
+	if x != nil {
+		return x.SpecialAttack
+	}
+	return 0
+}
+
+func (x *Stats) GetSpecialDefense() int32 {
+	if x != nil {
+		return x.SpecialDefense
+	}
+	return 0
+}
+
+func (x *Stats) GetSpeed() int32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *Stats) GetBaseStatTotal() int32 {
+	if x != nil {
+		return x.BaseStatTotal
+	}
+	return 0
+}
+
+type ListPokemonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Sort          string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	Order         string                 `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`
+	Cursor        string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"` // switches to keyset paging, see next_cursor
+	Q             string                 `protobuf:"bytes,6,opt,name=q,proto3" json:"q,omitempty"`
+	Types         []string               `protobuf:"bytes,7,rep,name=types,proto3" json:"types,omitempty"`
+	TypeMatch     string                 `protobuf:"bytes,8,opt,name=type_match,json=typeMatch,proto3" json:"type_match,omitempty"` // "any" (default) or "all"
+	TypesExact    []string               `protobuf:"bytes,9,rep,name=types_exact,json=typesExact,proto3" json:"types_exact,omitempty"`
+	Ability       string                 `protobuf:"bytes,10,opt,name=ability,proto3" json:"ability,omitempty"`
+	HiddenAbility string                 `protobuf:"bytes,11,opt,name=hidden_ability,json=hiddenAbility,proto3" json:"hidden_ability,omitempty"`
+	Min           map[string]int32       `protobuf:"bytes,12,rep,name=min,proto3" json:"min,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // keyed by height, weight, stat names or base_stat_total
+	Max           map[string]int32       `protobuf:"bytes,13,rep,name=max,proto3" json:"max,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPokemonRequest) Reset() {
+	*x = ListPokemonRequest{}
+	mi := &file_pokemon_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPokemonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPokemonRequest) ProtoMessage() {}
+
+func (x *ListPokemonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pokemon_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPokemonRequest.ProtoReflect.Descriptor instead.
+func (*ListPokemonRequest) Descriptor() ([]byte, []int) {
+	return file_pokemon_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPokemonRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPokemonRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListPokemonRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListPokemonRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *ListPokemonRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListPokemonRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *ListPokemonRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ListPokemonRequest) GetTypeMatch() string {
+	if x != nil {
+		return x.TypeMatch
+	}
+	return ""
+}
+
+func (x *ListPokemonRequest) GetTypesExact() []string {
+	if x != nil {
+		return x.TypesExact
+	}
+	return nil
+}
+
+func (x *ListPokemonRequest) GetAbility() string {
+	if x != nil {
+		return x.Ability
+	}
+	return ""
+}
+
+func (x *ListPokemonRequest) GetHiddenAbility() string {
+	if x != nil {
+		return x.HiddenAbility
+	}
+	return ""
+}
+
+func (x *ListPokemonRequest) GetMin() map[string]int32 {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *ListPokemonRequest) GetMax() map[string]int32 {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+type ListPokemonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pokemon       []*Pokemon             `protobuf:"bytes,1,rep,name=pokemon,proto3" json:"pokemon,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // offset paging only
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`   // offset paging only
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"` // offset paging only
+	HasNext       bool                   `protobuf:"varint,6,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+	HasPrevious   bool                   `protobuf:"varint,7,opt,name=has_previous,json=hasPrevious,proto3" json:"has_previous,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,8,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,9,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPokemonResponse) Reset() {
+	*x = ListPokemonResponse{}
+	mi := &file_pokemon_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPokemonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPokemonResponse) ProtoMessage() {}
+
+func (x *ListPokemonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pokemon_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPokemonResponse.ProtoReflect.Descriptor instead.
+func (*ListPokemonResponse) Descriptor() ([]byte, []int) {
+	return file_pokemon_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPokemonResponse) GetPokemon() []*Pokemon {
+	if x != nil {
+		return x.Pokemon
+	}
+	return nil
+}
+
+func (x *ListPokemonResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListPokemonResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPokemonResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPokemonResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *ListPokemonResponse) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
+func (x *ListPokemonResponse) GetHasPrevious() bool {
+	if x != nil {
+		return x.HasPrevious
+	}
+	return false
+}
+
+func (x *ListPokemonResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListPokemonResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
+type GetPokemonRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Lookup:
+	//
+	//	*GetPokemonRequest_Id
+	//	*GetPokemonRequest_Name
+	Lookup        isGetPokemonRequest_Lookup `protobuf_oneof:"lookup"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPokemonRequest) Reset() {
+	*x = GetPokemonRequest{}
+	mi := &file_pokemon_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPokemonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPokemonRequest) ProtoMessage() {}
+
+func (x *GetPokemonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pokemon_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPokemonRequest.ProtoReflect.Descriptor instead.
+func (*GetPokemonRequest) Descriptor() ([]byte, []int) {
+	return file_pokemon_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPokemonRequest) GetLookup() isGetPokemonRequest_Lookup {
+	if x != nil {
+		return x.Lookup
+	}
+	return nil
+}
+
+func (x *GetPokemonRequest) GetId() int32 {
+	if x != nil {
+		if x, ok := x.Lookup.(*GetPokemonRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return 0
+}
+
+func (x *GetPokemonRequest) GetName() string {
+	if x != nil {
+		if x, ok := x.Lookup.(*GetPokemonRequest_Name); ok {
+			return x.Name
+		}
+	}
+	return ""
+}
+
+type isGetPokemonRequest_Lookup interface {
+	isGetPokemonRequest_Lookup()
+}
+
+type GetPokemonRequest_Id struct {
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3,oneof"`
+}
+
+type GetPokemonRequest_Name struct {
+	Name string `protobuf:"bytes,2,opt,name=name,proto3,oneof"`
+}
+
+func (*GetPokemonRequest_Id) isGetPokemonRequest_Lookup() {}
+
+func (*GetPokemonRequest_Name) isGetPokemonRequest_Lookup() {}
+
+type StartSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartSyncRequest) Reset() {
+	*x = StartSyncRequest{}
+	mi := &file_pokemon_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSyncRequest) ProtoMessage() {}
+
+func (x *StartSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pokemon_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSyncRequest.ProtoReflect.Descriptor instead.
+func (*StartSyncRequest) Descriptor() ([]byte, []int) {
+	return file_pokemon_proto_rawDescGZIP(), []int{6}
+}
+
+type SyncJob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State         SyncJob_State          `protobuf:"varint,2,opt,name=state,proto3,enum=pokemon.v1.SyncJob_State" json:"state,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Processed     int32                  `protobuf:"varint,4,opt,name=processed,proto3" json:"processed,omitempty"`
+	Saved         int32                  `protobuf:"varint,5,opt,name=saved,proto3" json:"saved,omitempty"`
+	Failed        int32                  `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	Current       string                 `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"` // name of the Pokemon saved last
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Error         string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncJob) Reset() {
+	*x = SyncJob{}
+	mi := &file_pokemon_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncJob) ProtoMessage() {}
+
+func (x *SyncJob) ProtoReflect() protoreflect.Message {
+	mi := &file_pokemon_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncJob.ProtoReflect.Descriptor instead.
+func (*SyncJob) Descriptor() ([]byte, []int) {
+	return file_pokemon_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SyncJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SyncJob) GetState() SyncJob_State {
+	if x != nil {
+		return x.State
+	}
+	return SyncJob_STATE_UNSPECIFIED
+}
+
+func (x *SyncJob) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SyncJob) GetProcessed() int32 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *SyncJob) GetSaved() int32 {
+	if x != nil {
+		return x.Saved
+	}
+	return 0
+}
+
+func (x *SyncJob) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *SyncJob) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *SyncJob) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *SyncJob) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *SyncJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type WatchSyncJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSyncJobRequest) Reset() {
+	*x = WatchSyncJobRequest{}
+	mi := &file_pokemon_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSyncJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSyncJobRequest) ProtoMessage() {}
+
+func (x *WatchSyncJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pokemon_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSyncJobRequest.ProtoReflect.Descriptor instead.
+func (*WatchSyncJobRequest) Descriptor() ([]byte, []int) {
+	return file_pokemon_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WatchSyncJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetSyncStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SyncType      string                 `protobuf:"bytes,1,opt,name=sync_type,json=syncType,proto3" json:"sync_type,omitempty"` // defaults to "gen5"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncStatusRequest) Reset() {
+	*x = GetSyncStatusRequest{}
+	mi := &file_pokemon_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncStatusRequest) ProtoMessage() {}
+
+func (x *GetSyncStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pokemon_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSyncStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pokemon_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetSyncStatusRequest) GetSyncType() string {
+	if x != nil {
+		return x.SyncType
+	}
+	return ""
+}
+
+type SyncStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SyncType      string                 `protobuf:"bytes,1,opt,name=sync_type,json=syncType,proto3" json:"sync_type,omitempty"`
+	LastSyncAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_sync_at,json=lastSyncAt,proto3" json:"last_sync_at,omitempty"` // unset when never synced
+	TotalSynced   int32                  `protobuf:"varint,3,opt,name=total_synced,json=totalSynced,proto3" json:"total_synced,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncStatus) Reset() {
+	*x = SyncStatus{}
+	mi := &file_pokemon_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncStatus) ProtoMessage() {}
+
+func (x *SyncStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pokemon_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncStatus.ProtoReflect.Descriptor instead.
+func (*SyncStatus) Descriptor() ([]byte, []int) {
+	return file_pokemon_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SyncStatus) GetSyncType() string {
+	if x != nil {
+		return x.SyncType
+	}
+	return ""
+}
+
+func (x *SyncStatus) GetLastSyncAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncAt
+	}
+	return nil
+}
+
+func (x *SyncStatus) GetTotalSynced() int32 {
+	if x != nil {
+		return x.TotalSynced
+	}
+	return 0
+}
+
+func (x *SyncStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+var File_pokemon_proto protoreflect.FileDescriptor
+
+const file_pokemon_proto_rawDesc = "" +
+	"\n" +
+	"\rpokemon.proto\x12\n" +
+	"pokemon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf5\x02\n" +
+	"\aPokemon\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x05R\x06height\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x05R\x06weight\x12\x1d\n" +
+	"\n" +
+	"sprite_url\x18\x05 \x01(\tR\tspriteUrl\x12%\n" +
+	"\x0eanimated_front\x18\x06 \x01(\tR\ranimatedFront\x12#\n" +
+	"\ranimated_back\x18\a \x01(\tR\fanimatedBack\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
+	"\x05types\x18\t \x03(\tR\x05types\x121\n" +
+	"\tabilities\x18\n" +
+	" \x03(\v2\x13.pokemon.v1.AbilityR\tabilities\x12'\n" +
+	"\x05stats\x18\v \x01(\v2\x11.pokemon.v1.StatsR\x05stats\"N\n" +
+	"\aAbility\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tis_hidden\x18\x02 \x01(\bR\bisHidden\x12\x12\n" +
+	"\x04slot\x18\x03 \x01(\x05R\x04slot\"\xd7\x01\n" +
+	"\x05Stats\x12\x0e\n" +
+	"\x02hp\x18\x01 \x01(\x05R\x02hp\x12\x16\n" +
+	"\x06attack\x18\x02 \x01(\x05R\x06attack\x12\x18\n" +
+	"\adefense\x18\x03 \x01(\x05R\adefense\x12%\n" +
+	"\x0especial_attack\x18\x04 \x01(\x05R\rspecialAttack\x12'\n" +
+	"\x0fspecial_defense\x18\x05 \x01(\x05R\x0especialDefense\x12\x14\n" +
+	"\x05speed\x18\x06 \x01(\x05R\x05speed\x12&\n" +
+	"\x0fbase_stat_total\x18\a \x01(\x05R\rbaseStatTotal\"\x8f\x04\n" +
+	"\x12ListPokemonRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05order\x18\x04 \x01(\tR\x05order\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\f\n" +
+	"\x01q\x18\x06 \x01(\tR\x01q\x12\x14\n" +
+	"\x05types\x18\a \x03(\tR\x05types\x12\x1d\n" +
+	"\n" +
+	"type_match\x18\b \x01(\tR\ttypeMatch\x12\x1f\n" +
+	"\vtypes_exact\x18\t \x03(\tR\n" +
+	"typesExact\x12\x18\n" +
+	"\aability\x18\n" +
+	" \x01(\tR\aability\x12%\n" +
+	"\x0ehidden_ability\x18\v \x01(\tR\rhiddenAbility\x129\n" +
+	"\x03min\x18\f \x03(\v2'.pokemon.v1.ListPokemonRequest.MinEntryR\x03min\x129\n" +
+	"\x03max\x18\r \x03(\v2'.pokemon.v1.ListPokemonRequest.MaxEntryR\x03max\x1a6\n" +
+	"\bMinEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a6\n" +
+	"\bMaxEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xa5\x02\n" +
+	"\x13ListPokemonResponse\x12-\n" +
+	"\apokemon\x18\x01 \x03(\v2\x13.pokemon.v1.PokemonR\apokemon\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\x12\x19\n" +
+	"\bhas_next\x18\x06 \x01(\bR\ahasNext\x12!\n" +
+	"\fhas_previous\x18\a \x01(\bR\vhasPrevious\x12\x1f\n" +
+	"\vnext_cursor\x18\b \x01(\tR\n" +
+	"nextCursor\x12\x1f\n" +
+	"\vprev_cursor\x18\t \x01(\tR\n" +
+	"prevCursor\"E\n" +
+	"\x11GetPokemonRequest\x12\x10\n" +
+	"\x02id\x18\x01 \x01(\x05H\x00R\x02id\x12\x14\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04nameB\b\n" +
+	"\x06lookup\"\x12\n" +
+	"\x10StartSyncRequest\"\xae\x03\n" +
+	"\aSyncJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x19.pokemon.v1.SyncJob.StateR\x05state\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tprocessed\x18\x04 \x01(\x05R\tprocessed\x12\x14\n" +
+	"\x05saved\x18\x05 \x01(\x05R\x05saved\x12\x16\n" +
+	"\x06failed\x18\x06 \x01(\x05R\x06failed\x12\x18\n" +
+	"\acurrent\x18\a \x01(\tR\acurrent\x129\n" +
+	"\n" +
+	"started_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\"X\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSTATE_RUNNING\x10\x01\x12\x13\n" +
+	"\x0fSTATE_COMPLETED\x10\x02\x12\x10\n" +
+	"\fSTATE_FAILED\x10\x03\",\n" +
+	"\x13WatchSyncJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"3\n" +
+	"\x14GetSyncStatusRequest\x12\x1b\n" +
+	"\tsync_type\x18\x01 \x01(\tR\bsyncType\"\xa2\x01\n" +
+	"\n" +
+	"SyncStatus\x12\x1b\n" +
+	"\tsync_type\x18\x01 \x01(\tR\bsyncType\x12<\n" +
+	"\flast_sync_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSyncAt\x12!\n" +
+	"\ftotal_synced\x18\x03 \x01(\x05R\vtotalSynced\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status2\xf5\x02\n" +
+	"\x0ePokemonService\x12N\n" +
+	"\vListPokemon\x12\x1e.pokemon.v1.ListPokemonRequest\x1a\x1f.pokemon.v1.ListPokemonResponse\x12@\n" +
+	"\n" +
+	"GetPokemon\x12\x1d.pokemon.v1.GetPokemonRequest\x1a\x13.pokemon.v1.Pokemon\x12>\n" +
+	"\tStartSync\x12\x1c.pokemon.v1.StartSyncRequest\x1a\x13.pokemon.v1.SyncJob\x12F\n" +
+	"\fWatchSyncJob\x12\x1f.pokemon.v1.WatchSyncJobRequest\x1a\x13.pokemon.v1.SyncJob0\x01\x12I\n" +
+	"\rGetSyncStatus\x12 .pokemon.v1.GetSyncStatusRequest\x1a\x16.pokemon.v1.SyncStatusB\x19Z\x17pokeAPI/proto/pokemonpbb\x06proto3"
+
+var (
+	file_pokemon_proto_rawDescOnce sync.Once
+	file_pokemon_proto_rawDescData []byte
+)
+
+func file_pokemon_proto_rawDescGZIP() []byte {
+	file_pokemon_proto_rawDescOnce.Do(func() {
+		file_pokemon_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pokemon_proto_rawDesc), len(file_pokemon_proto_rawDesc)))
+	})
+	return file_pokemon_proto_rawDescData
+}
+
+var file_pokemon_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pokemon_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_pokemon_proto_goTypes = []any{
+	(SyncJob_State)(0),            // 0: pokemon.v1.SyncJob.State
+	(*Pokemon)(nil),               // 1: pokemon.v1.Pokemon
+	(*Ability)(nil),               // 2: pokemon.v1.Ability
+	(*Stats)(nil),                 // 3: pokemon.v1.Stats
+	(*ListPokemonRequest)(nil),    // 4: pokemon.v1.ListPokemonRequest
+	(*ListPokemonResponse)(nil),   // 5: pokemon.v1.ListPokemonResponse
+	(*GetPokemonRequest)(nil),     // 6: pokemon.v1.GetPokemonRequest
+	(*StartSyncRequest)(nil),      // 7: pokemon.v1.StartSyncRequest
+	(*SyncJob)(nil),               // 8: pokemon.v1.SyncJob
+	(*WatchSyncJobRequest)(nil),   // 9: pokemon.v1.WatchSyncJobRequest
+	(*GetSyncStatusRequest)(nil),  // 10: pokemon.v1.GetSyncStatusRequest
+	(*SyncStatus)(nil),            // 11: pokemon.v1.SyncStatus
+	nil,                           // 12: pokemon.v1.ListPokemonRequest.MinEntry
+	nil,                           // 13: pokemon.v1.ListPokemonRequest.MaxEntry
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+}
+var file_pokemon_proto_depIdxs = []int32{
+	14, // 0: pokemon.v1.Pokemon.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 1: pokemon.v1.Pokemon.abilities:type_name -> pokemon.v1.Ability
+	3,  // 2: pokemon.v1.Pokemon.stats:type_name -> pokemon.v1.Stats
+	12, // 3: pokemon.v1.ListPokemonRequest.min:type_name -> pokemon.v1.ListPokemonRequest.MinEntry
+	13, // 4: pokemon.v1.ListPokemonRequest.max:type_name -> pokemon.v1.ListPokemonRequest.MaxEntry
+	1,  // 5: pokemon.v1.ListPokemonResponse.pokemon:type_name -> pokemon.v1.Pokemon
+	0,  // 6: pokemon.v1.SyncJob.state:type_name -> pokemon.v1.SyncJob.State
+	14, // 7: pokemon.v1.SyncJob.started_at:type_name -> google.protobuf.Timestamp
+	14, // 8: pokemon.v1.SyncJob.finished_at:type_name -> google.protobuf.Timestamp
+	14, // 9: pokemon.v1.SyncStatus.last_sync_at:type_name -> google.protobuf.Timestamp
+	4,  // 10: pokemon.v1.PokemonService.ListPokemon:input_type -> pokemon.v1.ListPokemonRequest
+	6,  // 11: pokemon.v1.PokemonService.GetPokemon:input_type -> pokemon.v1.GetPokemonRequest
+	7,  // 12: pokemon.v1.PokemonService.StartSync:input_type -> pokemon.v1.StartSyncRequest
+	9,  // 13: pokemon.v1.PokemonService.WatchSyncJob:input_type -> pokemon.v1.WatchSyncJobRequest
+	10, // 14: pokemon.v1.PokemonService.GetSyncStatus:input_type -> pokemon.v1.GetSyncStatusRequest
+	5,  // 15: pokemon.v1.PokemonService.ListPokemon:output_type -> pokemon.v1.ListPokemonResponse
+	1,  // 16: pokemon.v1.PokemonService.GetPokemon:output_type -> pokemon.v1.Pokemon
+	8,  // 17: pokemon.v1.PokemonService.StartSync:output_type -> pokemon.v1.SyncJob
+	8,  // 18: pokemon.v1.PokemonService.WatchSyncJob:output_type -> pokemon.v1.SyncJob
+	11, // 19: pokemon.v1.PokemonService.GetSyncStatus:output_type -> pokemon.v1.SyncStatus
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_pokemon_proto_init() }
+func file_pokemon_proto_init() {
+	if File_pokemon_proto != nil {
+		return
+	}
+	file_pokemon_proto_msgTypes[5].OneofWrappers = []any{
+		(*GetPokemonRequest_Id)(nil),
+		(*GetPokemonRequest_Name)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pokemon_proto_rawDesc), len(file_pokemon_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pokemon_proto_goTypes,
+		DependencyIndexes: file_pokemon_proto_depIdxs,
+		EnumInfos:         file_pokemon_proto_enumTypes,
+		MessageInfos:      file_pokemon_proto_msgTypes,
+	}.Build()
+	File_pokemon_proto = out.File
+	file_pokemon_proto_goTypes = nil
+	file_pokemon_proto_depIdxs = nil
+}
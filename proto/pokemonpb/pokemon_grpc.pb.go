@@ -0,0 +1,291 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: pokemon.proto
+
+package pokemonpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PokemonService_ListPokemon_FullMethodName   = "/pokemon.v1.PokemonService/ListPokemon"
+	PokemonService_GetPokemon_FullMethodName    = "/pokemon.v1.PokemonService/GetPokemon"
+	PokemonService_StartSync_FullMethodName     = "/pokemon.v1.PokemonService/StartSync"
+	PokemonService_WatchSyncJob_FullMethodName  = "/pokemon.v1.PokemonService/WatchSyncJob"
+	PokemonService_GetSyncStatus_FullMethodName = "/pokemon.v1.PokemonService/GetSyncStatus"
+)
+
+// PokemonServiceClient is the client API for PokemonService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PokemonService is the typed counterpart of the REST API, served on GRPC_PORT
+type PokemonServiceClient interface {
+	// ListPokemon mirrors GET /api/pokemon: filters, sorting, offset or cursor paging
+	ListPokemon(ctx context.Context, in *ListPokemonRequest, opts ...grpc.CallOption) (*ListPokemonResponse, error)
+	// GetPokemon returns one Pokemon with types, abilities and stats
+	GetPokemon(ctx context.Context, in *GetPokemonRequest, opts ...grpc.CallOption) (*Pokemon, error)
+	// StartSync starts a Gen 5 sync, or returns the one already running
+	StartSync(ctx context.Context, in *StartSyncRequest, opts ...grpc.CallOption) (*SyncJob, error)
+	// WatchSyncJob streams a sync job's progress until it finishes
+	WatchSyncJob(ctx context.Context, in *WatchSyncJobRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SyncJob], error)
+	// GetSyncStatus returns when a sync last completed
+	GetSyncStatus(ctx context.Context, in *GetSyncStatusRequest, opts ...grpc.CallOption) (*SyncStatus, error)
+}
+
+type pokemonServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPokemonServiceClient(cc grpc.ClientConnInterface) PokemonServiceClient {
+	return &pokemonServiceClient{cc}
+}
+
+func (c *pokemonServiceClient) ListPokemon(ctx context.Context, in *ListPokemonRequest, opts ...grpc.CallOption) (*ListPokemonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPokemonResponse)
+	err := c.cc.Invoke(ctx, PokemonService_ListPokemon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokemonServiceClient) GetPokemon(ctx context.Context, in *GetPokemonRequest, opts ...grpc.CallOption) (*Pokemon, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pokemon)
+	err := c.cc.Invoke(ctx, PokemonService_GetPokemon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokemonServiceClient) StartSync(ctx context.Context, in *StartSyncRequest, opts ...grpc.CallOption) (*SyncJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncJob)
+	err := c.cc.Invoke(ctx, PokemonService_StartSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokemonServiceClient) WatchSyncJob(ctx context.Context, in *WatchSyncJobRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SyncJob], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PokemonService_ServiceDesc.Streams[0], PokemonService_WatchSyncJob_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSyncJobRequest, SyncJob]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PokemonService_WatchSyncJobClient = grpc.ServerStreamingClient[SyncJob]
+
+func (c *pokemonServiceClient) GetSyncStatus(ctx context.Context, in *GetSyncStatusRequest, opts ...grpc.CallOption) (*SyncStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncStatus)
+	err := c.cc.Invoke(ctx, PokemonService_GetSyncStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PokemonServiceServer is the server API for PokemonService service.
+// All implementations must embed UnimplementedPokemonServiceServer
+// for forward compatibility.
+//
+// PokemonService is the typed counterpart of the REST API, served on GRPC_PORT
+type PokemonServiceServer interface {
+	// ListPokemon mirrors GET /api/pokemon: filters, sorting, offset or cursor paging
+	ListPokemon(context.Context, *ListPokemonRequest) (*ListPokemonResponse, error)
+	// GetPokemon returns one Pokemon with types, abilities and stats
+	GetPokemon(context.Context, *GetPokemonRequest) (*Pokemon, error)
+	// StartSync starts a Gen 5 sync, or returns the one already running
+	StartSync(context.Context, *StartSyncRequest) (*SyncJob, error)
+	// WatchSyncJob streams a sync job's progress until it finishes
+	WatchSyncJob(*WatchSyncJobRequest, grpc.ServerStreamingServer[SyncJob]) error
+	// GetSyncStatus returns when a sync last completed
+	GetSyncStatus(context.Context, *GetSyncStatusRequest) (*SyncStatus, error)
+	mustEmbedUnimplementedPokemonServiceServer()
+}
+
+// UnimplementedPokemonServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPokemonServiceServer struct{}
+
+func (UnimplementedPokemonServiceServer) ListPokemon(context.Context, *ListPokemonRequest) (*ListPokemonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPokemon not implemented")
+}
+func (UnimplementedPokemonServiceServer) GetPokemon(context.Context, *GetPokemonRequest) (*Pokemon, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPokemon not implemented")
+}
+func (UnimplementedPokemonServiceServer) StartSync(context.Context, *StartSyncRequest) (*SyncJob, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartSync not implemented")
+}
+func (UnimplementedPokemonServiceServer) WatchSyncJob(*WatchSyncJobRequest, grpc.ServerStreamingServer[SyncJob]) error {
+	return status.Error(codes.Unimplemented, "method WatchSyncJob not implemented")
+}
+func (UnimplementedPokemonServiceServer) GetSyncStatus(context.Context, *GetSyncStatusRequest) (*SyncStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSyncStatus not implemented")
+}
+func (UnimplementedPokemonServiceServer) mustEmbedUnimplementedPokemonServiceServer() {}
+func (UnimplementedPokemonServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafePokemonServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PokemonServiceServer will
+// result in compilation errors.
+type UnsafePokemonServiceServer interface {
+	mustEmbedUnimplementedPokemonServiceServer()
+}
+
+func RegisterPokemonServiceServer(s grpc.ServiceRegistrar, srv PokemonServiceServer) {
+	// If the following call panics, it indicates UnimplementedPokemonServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PokemonService_ServiceDesc, srv)
+}
+
+func _PokemonService_ListPokemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPokemonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokemonServiceServer).ListPokemon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokemonService_ListPokemon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokemonServiceServer).ListPokemon(ctx, req.(*ListPokemonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokemonService_GetPokemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPokemonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokemonServiceServer).GetPokemon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokemonService_GetPokemon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokemonServiceServer).GetPokemon(ctx, req.(*GetPokemonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokemonService_StartSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokemonServiceServer).StartSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokemonService_StartSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokemonServiceServer).StartSync(ctx, req.(*StartSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokemonService_WatchSyncJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSyncJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PokemonServiceServer).WatchSyncJob(m, &grpc.GenericServerStream[WatchSyncJobRequest, SyncJob]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PokemonService_WatchSyncJobServer = grpc.ServerStreamingServer[SyncJob]
+
+func _PokemonService_GetSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokemonServiceServer).GetSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokemonService_GetSyncStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokemonServiceServer).GetSyncStatus(ctx, req.(*GetSyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PokemonService_ServiceDesc is the grpc.ServiceDesc for PokemonService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PokemonService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pokemon.v1.PokemonService",
+	HandlerType: (*PokemonServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPokemon",
+			Handler:    _PokemonService_ListPokemon_Handler,
+		},
+		{
+			MethodName: "GetPokemon",
+			Handler:    _PokemonService_GetPokemon_Handler,
+		},
+		{
+			MethodName: "StartSync",
+			Handler:    _PokemonService_StartSync_Handler,
+		},
+		{
+			MethodName: "GetSyncStatus",
+			Handler:    _PokemonService_GetSyncStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSyncJob",
+			Handler:       _PokemonService_WatchSyncJob_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pokemon.proto",
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// MaxBatchSize bounds how many Pokemon one batch request may ask for
+const MaxBatchSize = 100
+
+// GetPokemonBatch returns full details (types, abilities, stats and any extra includes) for many
+// Pokedex numbers or names in request order, plus the inputs that matched nothing.
+// It runs a fixed number of queries however many Pokemon are requested.
+func (s *PokemonService) GetPokemonBatch(idsOrNames []string, include []string) ([]map[string]interface{}, []string, error) {
+	resolved, err := s.ResolvePokemonBatch(idsOrNames)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pokedexIDs []int
+	for _, id := range resolved {
+		pokedexIDs = append(pokedexIDs, id)
+	}
+
+	rows, err := s.db.Query(`
+		SELECT `+pokemonListColumns+`, ''::text AS sort_key
+		FROM pokemon p
+		WHERE p.pokedex_id = ANY($1)
+	`, pq.Array(pokedexIDs))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query pokemon: %w", err)
+	}
+	page, _, err := scanPokemonList(rows, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, relation := range []string{IncludeAbilities, IncludeStats} {
+		if !containsString(include, relation) {
+			include = append(include, relation)
+		}
+	}
+	pokemons, err := s.expandPage(page, include)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byPokedexID := make(map[int]map[string]interface{}, len(page))
+	for i, row := range page {
+		byPokedexID[row.pokedexID] = pokemons[i]
+	}
+
+	// Put results back in request order, each Pokemon once
+	found := []map[string]interface{}{}
+	missing := []string{}
+	seen := map[int]bool{}
+	for _, idOrName := range idsOrNames {
+		pokemon, ok := byPokedexID[resolved[idOrName]]
+		if !ok {
+			missing = append(missing, idOrName)
+			continue
+		}
+		if id := resolved[idOrName]; !seen[id] {
+			seen[id] = true
+			found = append(found, pokemon)
+		}
+	}
+
+	return found, missing, nil
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package service
+
+// Bounds on how many Pokemon one comparison may hold
+const (
+	MinCompareSize = 2
+	MaxCompareSize = 6
+)
+
+// compareStats lists the stats lined up by a comparison, in display order
+var compareStats = []string{"hp", "attack", "defense", "special_attack", "special_defense", "speed", "base_stat_total"}
+
+// ComparePokemon lines up base stats, types and abilities of several Pokemon, given by Pokedex number or name.
+// Deltas are relative to the first Pokemon; winners list every Pokemon tied for the highest value.
+// It also returns the inputs that matched nothing.
+func (s *PokemonService) ComparePokemon(idsOrNames []string) (map[string]interface{}, []string, error) {
+	pokemons, missing, err := s.GetPokemonBatch(idsOrNames, nil)
+	if err != nil || len(missing) > 0 {
+		return nil, missing, err
+	}
+
+	ids := make([]int, len(pokemons))
+	typesOf := make([][]string, len(pokemons))
+	abilitiesOf := make([][]string, len(pokemons))
+	for i, pokemon := range pokemons {
+		ids[i], _ = pokemon["id"].(int)
+		typesOf[i], _ = pokemon["types"].([]string)
+		abilities, _ := pokemon["abilities"].([]map[string]interface{})
+		for _, a := range abilities {
+			name, _ := a["name"].(string)
+			abilitiesOf[i] = append(abilitiesOf[i], name)
+		}
+	}
+
+	return map[string]interface{}{
+		"pokemon":       pokemons,
+		"stats":         compareStatValues(pokemons, ids),
+		"types":         sharedAndUnique(ids, typesOf),
+		"abilities":     sharedAndUnique(ids, abilitiesOf),
+		"type_matchups": typeMatchups(ids, typesOf),
+	}, missing, nil
+}
+
+// compareStatValues aligns each stat across the Pokemon. Pokemon without stored stats get nulls.
+func compareStatValues(pokemons []map[string]interface{}, ids []int) map[string]interface{} {
+	result := make(map[string]interface{}, len(compareStats))
+	for _, stat := range compareStats {
+		values := make([]interface{}, len(pokemons))
+		deltas := make([]interface{}, len(pokemons))
+		winners := []int{}
+		best, hasBest := 0, false
+
+		base, hasBase := 0, false
+		if stats, ok := pokemons[0]["stats"].(map[string]interface{}); ok {
+			base, hasBase = stats[stat].(int)
+		}
+
+		for i, pokemon := range pokemons {
+			stats, ok := pokemon["stats"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			value, _ := stats[stat].(int)
+			values[i] = value
+			if hasBase {
+				deltas[i] = value - base
+			}
+
+			switch {
+			case !hasBest || value > best:
+				best, hasBest = value, true
+				winners = []int{ids[i]}
+			case value == best:
+				winners = append(winners, ids[i])
+			}
+		}
+
+		result[stat] = map[string]interface{}{
+			"values":  values,
+			"deltas":  deltas,
+			"winners": winners,
+		}
+	}
+	return result
+}
+
+// sharedAndUnique splits names into those every Pokemon has and those only one Pokemon has
+func sharedAndUnique(ids []int, namesOf [][]string) map[string]interface{} {
+	counts := map[string]int{}
+	for _, names := range namesOf {
+		for _, name := range names {
+			counts[name]++
+		}
+	}
+
+	shared := []string{}
+	for _, name := range namesOf[0] {
+		if counts[name] == len(namesOf) {
+			shared = append(shared, name)
+		}
+	}
+
+	unique := make([]map[string]interface{}, len(ids))
+	for i, names := range namesOf {
+		own := []string{}
+		for _, name := range names {
+			if counts[name] == 1 {
+				own = append(own, name)
+			}
+		}
+		unique[i] = map[string]interface{}{"id": ids[i], "names": own}
+	}
+
+	return map[string]interface{}{
+		"shared": shared,
+		"unique": unique,
+	}
+}
+
+// typeMatchups gives, for each ordered pair, the best multiplier the attacker's own types get against the defender
+func typeMatchups(ids []int, typesOf [][]string) []map[string]interface{} {
+	matchups := []map[string]interface{}{}
+	for i := range ids {
+		for j := range ids {
+			if i == j || len(typesOf[i]) == 0 || len(typesOf[j]) == 0 {
+				continue
+			}
+			bestType, multiplier := bestSTABEffectiveness(typesOf[i], typesOf[j])
+			matchups = append(matchups, map[string]interface{}{
+				"attacker":   ids[i],
+				"defender":   ids[j],
+				"type":       bestType,
+				"multiplier": multiplier,
+			})
+		}
+	}
+	return matchups
+}
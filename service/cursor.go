@@ -0,0 +1,181 @@
+package service
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidCursor is returned when a pagination cursor can't be decoded or names an unknown sort
+var ErrInvalidCursor = invalidArgument("cursor", "invalid cursor")
+
+// pokemonListColumns are the columns every list query selects, in scanPokemonList order
+const pokemonListColumns = `p.id, p.pokedex_id, p.name, p.height, p.weight, p.sprite_url,
+	p.animated_front, p.animated_back, p.created_at`
+
+// pageCursor is the decoded form of an opaque keyset cursor: the sort it belongs to,
+// the sort key and internal ID of the row it points at, and which way to page
+type pageCursor struct {
+	Sort  string `json:"s"`
+	Order string `json:"o"`
+	Key   string `json:"k"`
+	ID    int    `json:"i"`
+	Prev  bool   `json:"p,omitempty"`
+}
+
+// listRow is a scanned list row plus what's needed to build cursors and load relations
+type listRow struct {
+	id        int
+	pokedexID int
+	sortKey   string
+	data      map[string]interface{}
+}
+
+func encodeCursor(c pageCursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(token string) (pageCursor, error) {
+	var c pageCursor
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, ErrInvalidCursor
+	}
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return c, ErrInvalidCursor
+	}
+	if _, ok := sortColumns[c.Sort]; !ok || (c.Order != "asc" && c.Order != "desc") {
+		return c, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// scanPokemonList scans rows selected with pokemonListColumns, a sort_key and,
+// when withCount is set, a trailing window count. It closes rows.
+func scanPokemonList(rows *sql.Rows, withCount bool) ([]listRow, int, error) {
+	defer rows.Close()
+
+	totalCount := 0
+	var page []listRow
+	for rows.Next() {
+		var id, pokedexID, height, weight int
+		var name, spriteURL, animatedFront, animatedBack, createdAt, sortKey string
+
+		dest := []interface{}{&id, &pokedexID, &name, &height, &weight, &spriteURL, &animatedFront, &animatedBack, &createdAt, &sortKey}
+		if withCount {
+			dest = append(dest, &totalCount)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan pokemon: %w", err)
+		}
+
+		page = append(page, listRow{
+			id:        id,
+			pokedexID: pokedexID,
+			sortKey:   sortKey,
+			data: map[string]interface{}{
+				"id":             pokedexID,
+				"name":           name,
+				"height":         height,
+				"weight":         weight,
+				"sprite_url":     spriteURL,
+				"animated_front": animatedFront,
+				"animated_back":  animatedBack,
+				"created_at":     createdAt,
+			},
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read pokemon: %w", err)
+	}
+
+	return page, totalCount, nil
+}
+
+// GetPokemonByCursor retrieves a page of Pokemon after (or before) a keyset cursor.
+// The cursor fixes the sort and order; filters must be sent again with every page.
+// Unlike GetPokemonPaginated it never counts the full result set.
+func (s *PokemonService) GetPokemonByCursor(limit int, token string, filter PokemonFilter, include []string) (map[string]interface{}, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 20 // Default
+	}
+
+	cursor, err := decodeCursor(token)
+	if err != nil {
+		return nil, err
+	}
+	sortExpr := sortColumns[cursor.Sort]
+
+	// Paging backwards walks the reverse order from the cursor, then flips the page
+	forward := !cursor.Prev
+	cmp, order := ">", "asc"
+	if (cursor.Order == "desc") == forward {
+		cmp, order = "<", "desc"
+	}
+
+	conds, args := filter.conditions(nil)
+	args = append(args, cursor.Key, cursor.ID)
+	conds = append(conds, fmt.Sprintf("(%s, p.id) %s ($%d, $%d)", sortExpr, cmp, len(args)-1, len(args)))
+	args = append(args, limit+1)
+
+	query := `
+		SELECT ` + pokemonListColumns + `, (` + sortExpr + `)::text AS sort_key
+	` + pokemonFromClause + `
+		WHERE ` + strings.Join(conds, " AND ") + `
+		ORDER BY ` + sortExpr + ` ` + order + `, p.id ` + order + `
+		LIMIT ` + fmt.Sprintf("$%d", len(args))
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pokemon: %w", err)
+	}
+	page, _, err := scanPokemonList(rows, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// The extra row only tells us whether there is more in this direction
+	more := len(page) > limit
+	if more {
+		page = page[:limit]
+	}
+	if !forward {
+		for i, j := 0, len(page)-1; i < j; i, j = i+1, j-1 {
+			page[i], page[j] = page[j], page[i]
+		}
+	}
+
+	// Coming from a cursor means there is always something on the side we came from
+	hasNext, hasPrevious := more, true
+	if !forward {
+		hasNext, hasPrevious = true, more
+	}
+
+	pokemons, err := s.expandPage(page, include)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]interface{}{
+		"data":         pokemons,
+		"limit":        limit,
+		"sort":         cursor.Sort,
+		"order":        cursor.Order,
+		"has_next":     hasNext,
+		"has_previous": hasPrevious,
+	}
+	if len(page) > 0 {
+		last, first := page[len(page)-1], page[0]
+		if hasNext {
+			result["next_cursor"] = encodeCursor(pageCursor{Sort: cursor.Sort, Order: cursor.Order, Key: last.sortKey, ID: last.id})
+		}
+		if hasPrevious {
+			result["prev_cursor"] = encodeCursor(pageCursor{Sort: cursor.Sort, Order: cursor.Order, Key: first.sortKey, ID: first.id, Prev: true})
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,348 @@
+package service
+
+import (
+	"math"
+	"pokeAPI/model"
+)
+
+// Move categories accepted by the damage calculator
+const (
+	MoveCategoryPhysical = "physical"
+	MoveCategorySpecial  = "special"
+)
+
+// Weather conditions that change damage in Generation 5
+const (
+	WeatherNone = ""
+	WeatherSun  = "sun"
+	WeatherRain = "rain"
+	WeatherSand = "sand"
+	WeatherHail = "hail"
+)
+
+// DamageSide describes one Pokemon in a damage calculation. Level defaults to 100,
+// IVs to 31, EVs to 0 and nature to a neutral one. Boosts are stat stages from -6 to +6.
+type DamageSide struct {
+	ID      int        `json:"id"`
+	Level   int        `json:"level"`
+	IVs     StatSpread `json:"ivs"`
+	EVs     StatSpread `json:"evs"`
+	Nature  string     `json:"nature"`
+	Boosts  StatSpread `json:"boosts"`
+	Item    string     `json:"item"`
+	Ability string     `json:"ability"`
+	Status  string     `json:"status"` // "burn" halves physical damage
+}
+
+// DamageMove is the move used. There is no moves table, so callers pass its type, power and category.
+type DamageMove struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Power    int    `json:"power"`
+	Category string `json:"category"`
+}
+
+// DamageRequest is the input of CalculateDamage
+type DamageRequest struct {
+	Attacker DamageSide `json:"attacker"`
+	Defender DamageSide `json:"defender"`
+	Move     DamageMove `json:"move"`
+	Weather  string     `json:"weather"`
+	Critical bool       `json:"critical"`
+}
+
+// The 16 random damage factors run from 85% to 100%
+const minDamageRoll, maxDamageRoll = 85, 100
+
+// typeImmunityAbilities are defender abilities that absorb a move type entirely
+var typeImmunityAbilities = map[string]string{
+	"levitate":      "ground",
+	"flash-fire":    "fire",
+	"water-absorb":  "water",
+	"storm-drain":   "water",
+	"volt-absorb":   "electric",
+	"motor-drive":   "electric",
+	"lightning-rod": "electric",
+	"sap-sipper":    "grass",
+}
+
+// CalculateDamage runs the Generation 5 damage formula with all 16 random rolls, reading base stats
+// and types from the database. Items and abilities that change damage unconditionally are applied.
+func (s *PokemonService) CalculateDamage(req DamageRequest) (map[string]interface{}, error) {
+	if err := validateDamageRequest(&req); err != nil {
+		return nil, err
+	}
+
+	ids := []int{req.Attacker.ID, req.Defender.ID}
+	statsByPokemon, err := s.StatsByPokedexID(ids)
+	if err != nil {
+		return nil, err
+	}
+	typesByPokemon, err := s.TypesByPokedexID(ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		if statsByPokemon[id] == nil {
+			return nil, notFound("pokemon with id %d not found", id)
+		}
+	}
+
+	attacker, err := s.sideStats(req.Attacker, statsByPokemon[req.Attacker.ID])
+	if err != nil {
+		return nil, err
+	}
+	defender, err := s.sideStats(req.Defender, statsByPokemon[req.Defender.ID])
+	if err != nil {
+		return nil, err
+	}
+
+	var attackerTypes, defenderTypes []string
+	for _, t := range typesByPokemon[req.Attacker.ID] {
+		attackerTypes = append(attackerTypes, t.TypeName)
+	}
+	for _, t := range typesByPokemon[req.Defender.ID] {
+		defenderTypes = append(defenderTypes, t.TypeName)
+	}
+
+	rolls, effectiveness := damageRolls(req, attacker, defender, attackerTypes, defenderTypes)
+
+	hp := defender[StatHP]
+	return map[string]interface{}{
+		"attacker":      map[string]interface{}{"id": req.Attacker.ID, "types": attackerTypes, "stats": attacker},
+		"defender":      map[string]interface{}{"id": req.Defender.ID, "types": defenderTypes, "stats": defender},
+		"move":          req.Move,
+		"effectiveness": effectiveness,
+		"damage": map[string]interface{}{
+			"min":   rolls[0],
+			"max":   rolls[len(rolls)-1],
+			"rolls": rolls,
+		},
+		"percent": map[string]interface{}{
+			"min": percentOf(rolls[0], hp),
+			"max": percentOf(rolls[len(rolls)-1], hp),
+		},
+		"ko_chance": map[string]interface{}{
+			"one_hit": koChance(rolls, hp, 1),
+			"two_hit": koChance(rolls, hp, 2),
+		},
+	}, nil
+}
+
+// damageRolls applies the Generation 5 formula to a validated request and the sides' actual stats and types,
+// returning the damage for each random roll from lowest to highest and the move's type effectiveness
+func damageRolls(req DamageRequest, attacker, defender StatSpread, attackerTypes, defenderTypes []string) ([]int, float64) {
+	move := req.Move
+	physical := move.Category == MoveCategoryPhysical
+	attackAbility, defendAbility := NormalizeName(req.Attacker.Ability), NormalizeName(req.Defender.Ability)
+	attackItem, defendItem := NormalizeName(req.Attacker.Item), NormalizeName(req.Defender.Item)
+	burned := NormalizeName(req.Attacker.Status) == "burn"
+
+	effectiveness := TypeEffectiveness(move.Type, defenderTypes)
+	if immuneType, ok := typeImmunityAbilities[defendAbility]; ok && immuneType == move.Type {
+		effectiveness = 0
+	}
+	if defendAbility == "wonder-guard" && effectiveness <= 1 {
+		effectiveness = 0
+	}
+
+	// Attacking and defending stats with stages; critical hits ignore stages that would hurt the attacker
+	attackStat, defenseStat := StatSpecialAttack, StatSpecialDefense
+	if physical {
+		attackStat, defenseStat = StatAttack, StatDefense
+	}
+	attackStage, defenseStage := req.Attacker.Boosts[attackStat], req.Defender.Boosts[defenseStat]
+	if req.Critical {
+		attackStage = max(attackStage, 0)
+		defenseStage = min(defenseStage, 0)
+	}
+	attack := applyStage(attacker[attackStat], attackStage)
+	defense := applyStage(defender[defenseStat], defenseStage)
+
+	switch {
+	case physical && (attackAbility == "huge-power" || attackAbility == "pure-power"):
+		attack *= 2
+	case physical && attackAbility == "hustle":
+		attack = attack * 3 / 2
+	case physical && attackAbility == "guts" && req.Attacker.Status != "":
+		attack = attack * 3 / 2
+	case !physical && attackAbility == "solar-power" && req.Weather == WeatherSun:
+		attack = attack * 3 / 2
+	}
+	if (physical && attackItem == "choice-band") || (!physical && attackItem == "choice-specs") {
+		attack = attack * 3 / 2
+	}
+	if defendAbility == "thick-fat" && (move.Type == "fire" || move.Type == "ice") {
+		attack /= 2
+	}
+	if defendItem == "eviolite" {
+		defense = defense * 3 / 2
+	}
+	if !physical && req.Weather == WeatherSand && containsString(defenderTypes, "rock") {
+		defense = defense * 3 / 2
+	}
+
+	power := move.Power
+	if attackAbility == "technician" && power <= 60 {
+		power = power * 3 / 2
+	}
+
+	// Base damage, then the modifiers in the order the games apply them
+	base := (2*req.Attacker.Level/5+2)*power*attack/max(defense, 1)/50 + 2
+
+	switch {
+	case (req.Weather == WeatherSun && move.Type == "fire") || (req.Weather == WeatherRain && move.Type == "water"):
+		base = applyModifier(base, 6144)
+	case (req.Weather == WeatherSun && move.Type == "water") || (req.Weather == WeatherRain && move.Type == "fire"):
+		base = applyModifier(base, 2048)
+	}
+	if req.Critical {
+		if attackAbility == "sniper" {
+			base *= 3
+		} else {
+			base *= 2
+		}
+	}
+
+	rolls := make([]int, 0, maxDamageRoll-minDamageRoll+1)
+	for roll := minDamageRoll; roll <= maxDamageRoll; roll++ {
+		damage := base * roll / 100
+
+		if containsString(attackerTypes, move.Type) {
+			if attackAbility == "adaptability" {
+				damage *= 2
+			} else {
+				damage = applyModifier(damage, 6144)
+			}
+		}
+		damage = int(float64(damage) * effectiveness)
+		if burned && physical && attackAbility != "guts" {
+			damage /= 2
+		}
+
+		if attackItem == "life-orb" {
+			damage = applyModifier(damage, 5324)
+		}
+		if attackItem == "expert-belt" && effectiveness > 1 {
+			damage = applyModifier(damage, 4915)
+		}
+		if attackAbility == "tinted-lens" && effectiveness > 0 && effectiveness < 1 {
+			damage *= 2
+		}
+		if (defendAbility == "filter" || defendAbility == "solid-rock") && effectiveness > 1 {
+			damage = applyModifier(damage, 3072)
+		}
+		if defendAbility == "multiscale" {
+			damage = applyModifier(damage, 2048)
+		}
+
+		if effectiveness > 0 {
+			damage = max(damage, 1)
+		}
+		rolls = append(rolls, damage)
+	}
+	return rolls, effectiveness
+}
+
+// sideStats computes a side's actual stats from its base stats, level, IVs, EVs and nature
+func (s *PokemonService) sideStats(side DamageSide, base *model.PokemonStats) (StatSpread, error) {
+	nature, err := s.lookupNature(side.Nature)
+	if err != nil {
+		return nil, err
+	}
+	return actualStats(base, side.Level, nature, side.IVs, side.EVs), nil
+}
+
+// validateDamageRequest checks the request and fills in the default level
+func validateDamageRequest(req *DamageRequest) error {
+	for _, side := range []*DamageSide{&req.Attacker, &req.Defender} {
+		if err := validateDamageSide(side); err != nil {
+			if side == &req.Attacker {
+				return nestField("attacker", err)
+			}
+			return nestField("defender", err)
+		}
+	}
+
+	req.Move.Type = NormalizeName(req.Move.Type)
+	req.Move.Category = NormalizeName(req.Move.Category)
+	if !IsPokemonType(req.Move.Type) {
+		return invalidArgument("move.type", "unknown move type %q", req.Move.Type)
+	}
+	if req.Move.Category != MoveCategoryPhysical && req.Move.Category != MoveCategorySpecial {
+		return invalidArgument("move.category", "move category must be %q or %q", MoveCategoryPhysical, MoveCategorySpecial)
+	}
+	if req.Move.Power <= 0 {
+		return invalidArgument("move.power", "move power must be positive")
+	}
+
+	switch req.Weather = NormalizeName(req.Weather); req.Weather {
+	case WeatherNone, WeatherSun, WeatherRain, WeatherSand, WeatherHail:
+	default:
+		return invalidArgument("weather", "unknown weather %q", req.Weather)
+	}
+	return nil
+}
+
+// validateDamageSide checks one side's level, spread and boosts and fills in the default level
+func validateDamageSide(side *DamageSide) error {
+	if side.Level == 0 {
+		side.Level = defaultLevel
+	}
+	if err := validateLevel(side.Level); err != nil {
+		return err
+	}
+	if err := validateSpread(side.IVs, side.EVs); err != nil {
+		return err
+	}
+	for stat, stage := range side.Boosts {
+		if stat == StatHP || !containsString(StatNames, stat) {
+			return invalidArgument("boosts", "unknown stat %q in boosts", stat)
+		}
+		if stage < -6 || stage > 6 {
+			return invalidArgument("boosts."+stat, "boosts.%s must be between -6 and 6", stat)
+		}
+	}
+	return nil
+}
+
+// applyStage multiplies a stat by its stage multiplier: (2+n)/2 when raised, 2/(2+n) when lowered
+func applyStage(stat, stage int) int {
+	if stage >= 0 {
+		return stat * (2 + stage) / 2
+	}
+	return stat * 2 / (2 - stage)
+}
+
+// applyModifier applies a 4096-based modifier the way the games do, rounding halves down
+func applyModifier(value, modifier int) int {
+	return (value*modifier + 2047) / 4096
+}
+
+// percentOf returns damage as a percentage of HP, to one decimal
+func percentOf(damage, hp int) float64 {
+	return math.Round(float64(damage)*1000/float64(hp)) / 10
+}
+
+// koChance returns the chance that the given number of hits, each with an independent roll, knocks out a full-HP target
+func koChance(rolls []int, hp, hits int) float64 {
+	if hits == 1 {
+		kos := 0
+		for _, damage := range rolls {
+			if damage >= hp {
+				kos++
+			}
+		}
+		return float64(kos) / float64(len(rolls))
+	}
+
+	kos := 0
+	for _, first := range rolls {
+		for _, second := range rolls {
+			if first+second >= hp {
+				kos++
+			}
+		}
+	}
+	return float64(kos) / float64(len(rolls)*len(rolls))
+}
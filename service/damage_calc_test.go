@@ -0,0 +1,63 @@
+package service
+
+import "testing"
+
+// The base case is Bulbapedia's worked example: a level 75 Glaceon with 123 Attack using
+// Ice Fang on a Garchomp with 163 Defense does 168-196 damage
+func TestDamageRolls(t *testing.T) {
+	iceFang := DamageMove{Name: "ice-fang", Type: "ice", Power: 65, Category: MoveCategoryPhysical}
+	glaceon, garchomp := StatSpread{StatAttack: 123}, StatSpread{StatDefense: 163}
+
+	tests := []struct {
+		name          string
+		req           DamageRequest
+		defenderTypes []string
+		min, max      int
+		effectiveness float64
+	}{
+		{
+			name:          "published example",
+			req:           DamageRequest{Attacker: DamageSide{Level: 75}, Move: iceFang},
+			defenderTypes: []string{"dragon", "ground"},
+			min:           168, max: 196, effectiveness: 4,
+		},
+		{
+			name:          "critical hit doubles base damage",
+			req:           DamageRequest{Attacker: DamageSide{Level: 75}, Move: iceFang, Critical: true},
+			defenderTypes: []string{"dragon", "ground"},
+			min:           336, max: 396, effectiveness: 4,
+		},
+		{
+			name:          "burn halves physical damage",
+			req:           DamageRequest{Attacker: DamageSide{Level: 75, Status: "burn"}, Move: iceFang},
+			defenderTypes: []string{"dragon", "ground"},
+			min:           84, max: 98, effectiveness: 4,
+		},
+		{
+			name:          "neutral hit",
+			req:           DamageRequest{Attacker: DamageSide{Level: 75}, Move: iceFang},
+			defenderTypes: []string{"normal"},
+			min:           42, max: 49, effectiveness: 1,
+		},
+		{
+			name:          "absorbing ability",
+			req:           DamageRequest{Attacker: DamageSide{Level: 75}, Defender: DamageSide{Ability: "levitate"}, Move: DamageMove{Type: "ground", Power: 100, Category: MoveCategoryPhysical}},
+			defenderTypes: []string{"dragon"},
+			min:           0, max: 0, effectiveness: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rolls, effectiveness := damageRolls(tt.req, glaceon, garchomp, []string{"ice"}, tt.defenderTypes)
+			if len(rolls) != maxDamageRoll-minDamageRoll+1 {
+				t.Fatalf("got %d rolls, want %d", len(rolls), maxDamageRoll-minDamageRoll+1)
+			}
+			if rolls[0] != tt.min || rolls[len(rolls)-1] != tt.max {
+				t.Errorf("damage = %d-%d, want %d-%d", rolls[0], rolls[len(rolls)-1], tt.min, tt.max)
+			}
+			if effectiveness != tt.effectiveness {
+				t.Errorf("effectiveness = %v, want %v", effectiveness, tt.effectiveness)
+			}
+		})
+	}
+}
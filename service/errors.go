@@ -0,0 +1,71 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kinds of service error. Callers check them with errors.Is to pick a status code
+// instead of matching on error text.
+var (
+	ErrNotFound        = errors.New("not found")
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrConflict        = errors.New("conflict")
+	ErrUpstream        = errors.New("upstream error")
+)
+
+// Error is a service error of one of the kinds above. Message is safe to show to clients;
+// Field names the offending input for invalid arguments, and Err is the underlying cause, if any.
+type Error struct {
+	Kind    error
+	Message string
+	Field   string
+	Err     error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap makes errors.Is match both the kind and the underlying cause
+func (e *Error) Unwrap() []error {
+	if e.Err != nil {
+		return []error{e.Kind, e.Err}
+	}
+	return []error{e.Kind}
+}
+
+func notFound(format string, args ...interface{}) error {
+	return &Error{Kind: ErrNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+func invalidArgument(field, format string, args ...interface{}) error {
+	return &Error{Kind: ErrInvalidArgument, Message: fmt.Sprintf(format, args...), Field: field}
+}
+
+func conflict(format string, args ...interface{}) error {
+	return &Error{Kind: ErrConflict, Message: fmt.Sprintf(format, args...)}
+}
+
+func upstream(err error, format string, args ...interface{}) error {
+	return &Error{Kind: ErrUpstream, Message: fmt.Sprintf(format, args...), Err: err}
+}
+
+// nestField places an invalid argument under prefix, so "level" on the attacker becomes
+// "attacker.level". Other errors are returned unchanged.
+func nestField(prefix string, err error) error {
+	var e *Error
+	if !errors.As(err, &e) || !errors.Is(e.Kind, ErrInvalidArgument) {
+		return err
+	}
+	nested := *e
+	nested.Field = prefix
+	if e.Field != "" {
+		nested.Field += "." + e.Field
+	}
+	nested.Message = prefix + ": " + e.Message
+	return &nested
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+)
+
+// ExportBatchSize is how many rows each FETCH pulls from the export cursor
+const ExportBatchSize = 500
+
+// ExportRow is one Pokemon flattened for CSV and NDJSON export. Stats are nil when none are stored.
+type ExportRow struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	Height         int    `json:"height"`
+	Weight         int    `json:"weight"`
+	SpriteURL      string `json:"sprite_url"`
+	Type1          string `json:"type_1"`
+	Type2          string `json:"type_2"`
+	Ability1       string `json:"ability_1"`
+	Ability2       string `json:"ability_2"`
+	HiddenAbility  string `json:"hidden_ability"`
+	HP             *int   `json:"hp"`
+	Attack         *int   `json:"attack"`
+	Defense        *int   `json:"defense"`
+	SpecialAttack  *int   `json:"special_attack"`
+	SpecialDefense *int   `json:"special_defense"`
+	Speed          *int   `json:"speed"`
+	BaseStatTotal  *int   `json:"base_stat_total"`
+}
+
+// ExportColumns is the CSV header, in ExportRow.Record order
+var ExportColumns = []string{
+	"id", "name", "height", "weight", "sprite_url", "type_1", "type_2",
+	"ability_1", "ability_2", "hidden_ability",
+	"hp", "attack", "defense", "special_attack", "special_defense", "speed", "base_stat_total",
+}
+
+// Record returns the row as CSV fields; missing stats are left empty
+func (r ExportRow) Record() []string {
+	optional := func(v *int) string {
+		if v == nil {
+			return ""
+		}
+		return strconv.Itoa(*v)
+	}
+	return []string{
+		strconv.Itoa(r.ID), r.Name, strconv.Itoa(r.Height), strconv.Itoa(r.Weight), r.SpriteURL,
+		r.Type1, r.Type2, r.Ability1, r.Ability2, r.HiddenAbility,
+		optional(r.HP), optional(r.Attack), optional(r.Defense), optional(r.SpecialAttack),
+		optional(r.SpecialDefense), optional(r.Speed), optional(r.BaseStatTotal),
+	}
+}
+
+// ExportPokemon streams every Pokemon matching the filter to emit, in Pokedex order. Rows are read
+// through a server-side cursor a batch at a time, so memory stays flat however many rows match.
+func (s *PokemonService) ExportPokemon(filter PokemonFilter, emit func(row *ExportRow) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin export: %w", err)
+	}
+	defer tx.Rollback()
+
+	where, args := filter.whereClause(nil)
+	_, err = tx.Exec(`
+		DECLARE pokemon_export NO SCROLL CURSOR FOR
+		SELECT p.pokedex_id, p.name, p.height, p.weight, p.sprite_url,
+			COALESCE((SELECT pt.type_name FROM pokemon_types pt WHERE pt.pokemon_id = p.id AND pt.slot = 1), ''),
+			COALESCE((SELECT pt.type_name FROM pokemon_types pt WHERE pt.pokemon_id = p.id AND pt.slot = 2), ''),
+			COALESCE((SELECT pa.ability_name FROM pokemon_abilities pa WHERE pa.pokemon_id = p.id AND NOT pa.is_hidden ORDER BY pa.slot LIMIT 1), ''),
+			COALESCE((SELECT pa.ability_name FROM pokemon_abilities pa WHERE pa.pokemon_id = p.id AND NOT pa.is_hidden ORDER BY pa.slot OFFSET 1 LIMIT 1), ''),
+			COALESCE((SELECT pa.ability_name FROM pokemon_abilities pa WHERE pa.pokemon_id = p.id AND pa.is_hidden ORDER BY pa.slot LIMIT 1), ''),
+			ps.hp, ps.attack, ps.defense, ps.special_attack, ps.special_defense, ps.speed,
+			`+baseStatTotalExpr+`
+	`+pokemonFromClause+where+`
+		ORDER BY p.pokedex_id
+	`, args...)
+	if err != nil {
+		return fmt.Errorf("failed to open export cursor: %w", err)
+	}
+
+	for {
+		rows, err := tx.Query(fmt.Sprintf(`FETCH %d FROM pokemon_export`, ExportBatchSize))
+		if err != nil {
+			return fmt.Errorf("failed to fetch export rows: %w", err)
+		}
+
+		fetched := 0
+		for rows.Next() {
+			var r ExportRow
+			var hp, attack, defense, specialAttack, specialDefense, speed, total sql.NullInt64
+			if err := rows.Scan(&r.ID, &r.Name, &r.Height, &r.Weight, &r.SpriteURL,
+				&r.Type1, &r.Type2, &r.Ability1, &r.Ability2, &r.HiddenAbility,
+				&hp, &attack, &defense, &specialAttack, &specialDefense, &speed, &total); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan export row: %w", err)
+			}
+			r.HP, r.Attack, r.Defense = optionalInt(hp), optionalInt(attack), optionalInt(defense)
+			r.SpecialAttack, r.SpecialDefense, r.Speed = optionalInt(specialAttack), optionalInt(specialDefense), optionalInt(speed)
+			r.BaseStatTotal = optionalInt(total)
+
+			if err := emit(&r); err != nil {
+				rows.Close()
+				return err
+			}
+			fetched++
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("failed to read export rows: %w", err)
+		}
+
+		if fetched < ExportBatchSize {
+			return nil
+		}
+	}
+}
+
+// optionalInt turns a nullable column into a pointer, nil for NULL
+func optionalInt(v sql.NullInt64) *int {
+	if !v.Valid {
+		return nil
+	}
+	n := int(v.Int64)
+	return &n
+}
@@ -0,0 +1,134 @@
+package service
+
+import "sort"
+
+// HiddenPowerTypes lists the types Hidden Power can have, indexed by the type formula
+var HiddenPowerTypes = []string{
+	"fighting", "flying", "poison", "ground", "rock", "bug", "ghost", "steel",
+	"fire", "water", "grass", "electric", "psychic", "ice", "dragon", "dark",
+}
+
+// hiddenPowerStatOrder is the stat order the Hidden Power formulas weight the IV bits in
+var hiddenPowerStatOrder = []string{StatHP, StatAttack, StatDefense, StatSpeed, StatSpecialAttack, StatSpecialDefense}
+
+// maxHiddenPowerSpreads bounds how many spreads the reverse lookup returns
+const maxHiddenPowerSpreads = 10
+
+// HiddenPower returns the Generation 5 Hidden Power type and base power (30-70) for a full set of IVs
+func HiddenPower(ivs StatSpread) (string, int) {
+	typeBits, powerBits := 0, 0
+	for i, stat := range hiddenPowerStatOrder {
+		typeBits += (ivs[stat] & 1) << i
+		powerBits += (ivs[stat] >> 1 & 1) << i
+	}
+	return HiddenPowerTypes[typeBits*15/63], powerBits*40/63 + 30
+}
+
+// CalculateHiddenPower validates a set of IVs (missing ones count as 31) and returns its Hidden Power
+func CalculateHiddenPower(ivs StatSpread) (map[string]interface{}, error) {
+	if err := validateSpread(ivs, nil); err != nil {
+		return nil, err
+	}
+	ivs, _ = withDefaults(ivs, nil)
+
+	hpType, power := HiddenPower(ivs)
+	return map[string]interface{}{
+		"ivs":   ivs,
+		"type":  hpType,
+		"power": power,
+	}, nil
+}
+
+// HiddenPowerSpreads lists IV spreads of 30s and 31s that give Hidden Power the target type at 70 power.
+// With a Pokemon (pokedexID > 0) lowering the weaker of its attacking stats is free, and spreads
+// that lower its highest base stats are ranked last.
+func (s *PokemonService) HiddenPowerSpreads(targetType string, pokedexID int) (map[string]interface{}, error) {
+	targetType = NormalizeName(targetType)
+	if !containsString(HiddenPowerTypes, targetType) {
+		return nil, invalidArgument("type", "Hidden Power can't be %q", targetType)
+	}
+
+	// Without a Pokemon every stat matters equally
+	weights := StatSpread{}
+	for _, stat := range StatNames {
+		weights[stat] = 1
+	}
+	dump := ""
+	if pokedexID > 0 {
+		statsByPokemon, err := s.StatsByPokedexID([]int{pokedexID})
+		if err != nil {
+			return nil, err
+		}
+		base := statsByPokemon[pokedexID]
+		if base == nil {
+			return nil, notFound("stats for pokemon with id %d not found", pokedexID)
+		}
+		for _, stat := range StatNames {
+			weights[stat] = baseStat(base, stat)
+		}
+		dump = StatSpecialAttack
+		if base.Attack < base.SpecialAttack {
+			dump = StatAttack
+		}
+		weights[dump] = 0
+	}
+
+	type spread struct {
+		ivs     StatSpread
+		lowered []string
+		cost    int
+	}
+	var spreads []spread
+	for bits := 0; bits < 1<<len(hiddenPowerStatOrder); bits++ {
+		ivs := StatSpread{}
+		var lowered []string
+		cost := 0
+		for i, stat := range hiddenPowerStatOrder {
+			// 30 and 31 differ only in the type bit, so power stays at 70
+			if bits>>i&1 == 1 {
+				ivs[stat] = MaxIV
+			} else {
+				ivs[stat] = MaxIV - 1
+				lowered = append(lowered, stat)
+				cost += weights[stat]
+			}
+		}
+
+		if hpType, _ := HiddenPower(ivs); hpType != targetType {
+			continue
+		}
+		spreads = append(spreads, spread{ivs, lowered, cost})
+	}
+
+	// Fewest and least important IVs lowered first
+	sort.SliceStable(spreads, func(i, j int) bool {
+		if spreads[i].cost != spreads[j].cost {
+			return spreads[i].cost < spreads[j].cost
+		}
+		return len(spreads[i].lowered) < len(spreads[j].lowered)
+	})
+
+	results := []map[string]interface{}{}
+	for i := 0; i < len(spreads) && i < maxHiddenPowerSpreads; i++ {
+		_, power := HiddenPower(spreads[i].ivs)
+		lowered := spreads[i].lowered
+		if lowered == nil {
+			lowered = []string{}
+		}
+		results = append(results, map[string]interface{}{
+			"ivs":     spreads[i].ivs,
+			"power":   power,
+			"lowered": lowered,
+		})
+	}
+
+	result := map[string]interface{}{
+		"type":    targetType,
+		"spreads": results,
+	}
+	if pokedexID > 0 {
+		result["id"] = pokedexID
+		result["dump_stat"] = dump
+	}
+	return result, nil
+}
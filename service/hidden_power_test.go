@@ -0,0 +1,71 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestHiddenPower(t *testing.T) {
+	tests := []struct {
+		name      string
+		ivs       StatSpread
+		wantType  string
+		wantPower int
+	}{
+		{"all 31", StatSpread{}, "dark", 70},
+		{"fire", StatSpread{StatAttack: 30, StatSpecialAttack: 30, StatSpeed: 30}, "fire", 70},
+		{"ice", StatSpread{StatAttack: 30, StatDefense: 30}, "ice", 70},
+		{"grass", StatSpread{StatHP: 30, StatSpecialAttack: 30}, "grass", 70},
+		{"ground", StatSpread{StatSpecialAttack: 30, StatSpecialDefense: 30}, "ground", 70},
+		{"all 0", StatSpread{StatHP: 0, StatAttack: 0, StatDefense: 0, StatSpecialAttack: 0, StatSpecialDefense: 0, StatSpeed: 0}, "fighting", 30},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CalculateHiddenPower(tt.ivs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result["type"] != tt.wantType || result["power"] != tt.wantPower {
+				t.Errorf("got %v/%v, want %s/%d", result["type"], result["power"], tt.wantType, tt.wantPower)
+			}
+		})
+	}
+}
+
+// Without a Pokemon the reverse lookup never touches the database
+func TestHiddenPowerSpreads(t *testing.T) {
+	s := &PokemonService{}
+
+	tests := []struct {
+		target      string
+		wantLowered []string // the best spread
+	}{
+		{"dark", []string{}},
+		{"ice", []string{StatSpeed}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			result, err := s.HiddenPowerSpreads(tt.target, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			spreads := result["spreads"].([]map[string]interface{})
+			if len(spreads) == 0 {
+				t.Fatal("no spreads")
+			}
+			if lowered := spreads[0]["lowered"]; !reflect.DeepEqual(lowered, tt.wantLowered) {
+				t.Errorf("best spread lowers %v, want %v", lowered, tt.wantLowered)
+			}
+			for _, spread := range spreads {
+				if hpType, power := HiddenPower(spread["ivs"].(StatSpread)); hpType != tt.target || power != 70 {
+					t.Errorf("spread %v gives %s/%d, want %s/70", spread["ivs"], hpType, power, tt.target)
+				}
+			}
+		})
+	}
+
+	if _, err := s.HiddenPowerSpreads("normal", 0); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("normal: got %v, want an invalid argument", err)
+	}
+}
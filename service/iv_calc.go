@@ -0,0 +1,112 @@
+package service
+
+import (
+	"fmt"
+	"pokeAPI/model"
+)
+
+// StatObservation is one set of stats read off the summary screen, with the level and EVs at the time
+type StatObservation struct {
+	Level int        `json:"level"`
+	EVs   StatSpread `json:"evs"`
+	Stats StatSpread `json:"stats"`
+}
+
+// CalculateIVs works out which IVs can produce the observed stats. Each observation narrows the
+// candidates further; a stat left with no candidate means the inputs contradict each other.
+func (s *PokemonService) CalculateIVs(pokedexID int, natureName string, observations []StatObservation) (map[string]interface{}, error) {
+	if len(observations) == 0 {
+		return nil, invalidArgument("observations", "at least one observation is required")
+	}
+	for i, obs := range observations {
+		if err := validateObservation(obs); err != nil {
+			return nil, nestField(fmt.Sprintf("observations[%d]", i), err)
+		}
+	}
+
+	nature, err := s.lookupNature(natureName)
+	if err != nil {
+		return nil, err
+	}
+
+	statsByPokemon, err := s.StatsByPokedexID([]int{pokedexID})
+	if err != nil {
+		return nil, err
+	}
+	base := statsByPokemon[pokedexID]
+	if base == nil {
+		return nil, notFound("stats for pokemon with id %d not found", pokedexID)
+	}
+
+	ivs, inconsistent := matchIVs(base, nature, observations)
+
+	return map[string]interface{}{
+		"id":           pokedexID,
+		"nature":       nature,
+		"ivs":          ivs,
+		"consistent":   len(inconsistent) == 0,
+		"inconsistent": inconsistent,
+	}, nil
+}
+
+// matchIVs narrows each stat's IVs to those that produce every observed value. A stat never observed
+// maps to nil; so does one no IV fits, which is also listed in inconsistent.
+func matchIVs(base *model.PokemonStats, nature Nature, observations []StatObservation) (map[string]interface{}, []string) {
+	ivs := make(map[string]interface{}, len(StatNames))
+	inconsistent := []string{}
+	for _, stat := range StatNames {
+		observed := false
+		var possible []int
+		for iv := 0; iv <= MaxIV; iv++ {
+			matches := true
+			for _, obs := range observations {
+				value, ok := obs.Stats[stat]
+				if !ok {
+					continue
+				}
+				observed = true
+				if calcStat(stat, baseStat(base, stat), iv, obs.EVs[stat], obs.Level, nature.modifier(stat)) != value {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				possible = append(possible, iv)
+			}
+		}
+
+		switch {
+		case !observed:
+			ivs[stat] = nil
+		case len(possible) == 0:
+			inconsistent = append(inconsistent, stat)
+			ivs[stat] = nil
+		default:
+			ivs[stat] = map[string]interface{}{
+				"min":      possible[0],
+				"max":      possible[len(possible)-1],
+				"possible": possible,
+			}
+		}
+	}
+	return ivs, inconsistent
+}
+
+// validateObservation checks an observation's level, EVs and stat names
+func validateObservation(obs StatObservation) error {
+	if err := validateLevel(obs.Level); err != nil {
+		return err
+	}
+	if err := validateSpread(nil, obs.EVs); err != nil {
+		return err
+	}
+	if len(obs.Stats) == 0 {
+		return invalidArgument("stats", "stats are required")
+	}
+	for stat := range obs.Stats {
+		if !containsString(StatNames, stat) {
+			return invalidArgument("stats", "unknown stat %q in stats", stat)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"errors"
+	"pokeAPI/model"
+	"reflect"
+	"testing"
+)
+
+// An Adamant Garchomp with 31 HP and 20 Attack IVs and no EVs, seen at level 50 and again at 100
+func TestMatchIVs(t *testing.T) {
+	garchomp := &model.PokemonStats{HP: 108, Attack: 130, Defense: 95, SpecialAttack: 80, SpecialDefense: 85, Speed: 102}
+	adamant := Nature{Name: "adamant", Increased: StatAttack, Decreased: StatSpecialAttack}
+	level50 := StatObservation{Level: 50, Stats: StatSpread{StatHP: 183, StatAttack: 159}}
+	level100 := StatObservation{Level: 100, Stats: StatSpread{StatHP: 357, StatAttack: 313}}
+
+	tests := []struct {
+		name             string
+		observations     []StatObservation
+		wantPossible     map[string][]int // stats not listed must come back nil
+		wantInconsistent []string
+	}{
+		{
+			name:         "level 50 leaves two candidates each",
+			observations: []StatObservation{level50},
+			wantPossible: map[string][]int{StatHP: {30, 31}, StatAttack: {20, 21}},
+		},
+		{
+			name:         "level 100 narrows to one",
+			observations: []StatObservation{level50, level100},
+			wantPossible: map[string][]int{StatHP: {31}, StatAttack: {20}},
+		},
+		{
+			name:             "contradicting attack",
+			observations:     []StatObservation{level50, {Level: 100, Stats: StatSpread{StatHP: 357, StatAttack: 325}}},
+			wantPossible:     map[string][]int{StatHP: {31}},
+			wantInconsistent: []string{StatAttack},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ivs, inconsistent := matchIVs(garchomp, adamant, tt.observations)
+			for _, stat := range StatNames {
+				want, ok := tt.wantPossible[stat]
+				if !ok {
+					if ivs[stat] != nil {
+						t.Errorf("%s = %v, want nil", stat, ivs[stat])
+					}
+					continue
+				}
+				got, _ := ivs[stat].(map[string]interface{})
+				if !reflect.DeepEqual(got["possible"], want) {
+					t.Errorf("%s possible = %v, want %v", stat, got["possible"], want)
+				}
+			}
+			if want := tt.wantInconsistent; len(want) > 0 || len(inconsistent) > 0 {
+				if !reflect.DeepEqual(inconsistent, want) {
+					t.Errorf("inconsistent = %v, want %v", inconsistent, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateIVsNeedsObservations(t *testing.T) {
+	s := &PokemonService{}
+	if _, err := s.CalculateIVs(445, "adamant", nil); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("got %v, want an invalid argument", err)
+	}
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/lib/pq"
+)
+
+var nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]`)
+
+// compactName reduces a name to lowercase letters and digits, so "Mr. Mime", "mr-mime" and "mrmime" compare equal
+func compactName(name string) string {
+	return nonAlphanumeric.ReplaceAllString(NormalizeName(name), "")
+}
+
+// nameMatchQuery selects the best Pokemon for a slug and compact name, given as SQL expressions.
+// Exact names win, then compact and species-name matches, then the alias table.
+func nameMatchQuery(slug, compact string) string {
+	return fmt.Sprintf(`
+		SELECT p.pokedex_id, p.name
+		FROM pokemon p
+		LEFT JOIN pokemon_raw r ON r.pokemon_id = p.id
+		WHERE p.name = %[1]s
+			OR regexp_replace(p.name, '[^a-z0-9]', '', 'g') = %[2]s
+			OR r.payload->'species'->>'name' = %[1]s
+			OR p.name IN (SELECT pokemon_name FROM pokemon_aliases WHERE alias = %[2]s)
+		ORDER BY (p.name = %[1]s) DESC, p.pokedex_id
+		LIMIT 1
+	`, slug, compact)
+}
+
+// ResolvePokemon resolves a Pokedex number, name, slug, form or species name, or alias
+// to a Pokedex ID and its canonical PokeAPI name. Numbers are returned as-is with an empty name.
+func (s *PokemonService) ResolvePokemon(idOrName string) (int, string, error) {
+	if id, err := strconv.Atoi(idOrName); err == nil {
+		return id, "", nil
+	}
+
+	slug, compact := NormalizeName(idOrName), compactName(idOrName)
+	if compact == "" {
+		return 0, "", notFound("pokemon %q not found", idOrName)
+	}
+
+	var pokedexID int
+	var name string
+	err := s.db.QueryRow(nameMatchQuery("$1", "$2"), slug, compact).Scan(&pokedexID, &name)
+
+	if err == sql.ErrNoRows {
+		return 0, "", notFound("pokemon %q not found", idOrName)
+	}
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to resolve pokemon: %w", err)
+	}
+
+	return pokedexID, name, nil
+}
+
+// ResolvePokemonBatch resolves many Pokedex numbers and names in a single query.
+// The result maps each input that matched to its Pokedex ID; inputs with no match are left out.
+func (s *PokemonService) ResolvePokemonBatch(idsOrNames []string) (map[string]int, error) {
+	resolved := make(map[string]int, len(idsOrNames))
+
+	var inputs, slugs, compacts []string
+	for _, idOrName := range idsOrNames {
+		if id, err := strconv.Atoi(idOrName); err == nil {
+			resolved[idOrName] = id
+			continue
+		}
+		if compact := compactName(idOrName); compact != "" {
+			inputs = append(inputs, idOrName)
+			slugs = append(slugs, NormalizeName(idOrName))
+			compacts = append(compacts, compact)
+		}
+	}
+	if len(inputs) == 0 {
+		return resolved, nil
+	}
+
+	// One lateral lookup per name, all in the same round trip
+	rows, err := s.db.Query(`
+		SELECT k.ord, m.pokedex_id
+		FROM unnest($1::text[], $2::text[]) WITH ORDINALITY AS k(slug, compact, ord)
+		CROSS JOIN LATERAL (`+nameMatchQuery("k.slug", "k.compact")+`) m
+	`, pq.Array(slugs), pq.Array(compacts))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve pokemon: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var ord, pokedexID int
+		if err := rows.Scan(&ord, &pokedexID); err != nil {
+			return nil, fmt.Errorf("failed to scan pokemon: %w", err)
+		}
+		resolved[inputs[ord-1]] = pokedexID
+	}
+
+	return resolved, rows.Err()
+}
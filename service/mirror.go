@@ -0,0 +1,192 @@
+package service
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// pokeAPIPokemonLink matches a quoted link to one of PokeAPI's pokemon resources, such as
+// "https://pokeapi.co/api/v2/pokemon/494/", but not sub-resources like .../494/encounters
+var pokeAPIPokemonLink = regexp.MustCompile(`"` + regexp.QuoteMeta(pokeAPIBaseURL+"/pokemon/") + `[^/"]+/"`)
+
+// GetPokeAPIPokemon returns a Pokemon in PokeAPI's shape, served only from our database. apiBase is
+// the mirror's own /api/v2 URL. The mirror only serves pokemon resources, so only links to those are
+// pointed at apiBase; types, abilities, species and the rest keep pointing at PokeAPI. The stored
+// upstream payload is used when we have one, otherwise the response is rebuilt from our tables.
+func (s *PokemonService) GetPokeAPIPokemon(pokedexID int, apiBase string) (interface{}, error) {
+	var payload []byte
+	err := s.db.QueryRow(`
+		SELECT r.payload
+		FROM pokemon_raw r
+		INNER JOIN pokemon p ON p.id = r.pokemon_id
+		WHERE p.pokedex_id = $1
+	`, pokedexID).Scan(&payload)
+
+	if err == nil {
+		oldPrefix := len(`"` + pokeAPIBaseURL)
+		return json.RawMessage(pokeAPIPokemonLink.ReplaceAllFunc(payload, func(link []byte) []byte {
+			return append([]byte(`"`+apiBase), link[oldPrefix:]...)
+		})), nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to query raw payload: %w", err)
+	}
+
+	return s.rebuildPokeAPIPokemon(pokedexID)
+}
+
+// rebuildPokeAPIPokemon builds PokeAPI's pokemon schema from our tables. Resource links point at PokeAPI
+// and use names, which it accepts as well as IDs. Values we never stored (base experience, EV yields, moves
+// and so on) are null or empty rather than made up.
+func (s *PokemonService) rebuildPokeAPIPokemon(pokedexID int) (map[string]interface{}, error) {
+	pokemon, err := s.GetPokemonByID(pokedexID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int{pokedexID}
+	typesByPokemon, err := s.TypesByPokedexID(ids)
+	if err != nil {
+		return nil, err
+	}
+	abilitiesByPokemon, err := s.AbilitiesByPokedexID(ids)
+	if err != nil {
+		return nil, err
+	}
+	statsByPokemon, err := s.StatsByPokedexID(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// Species whose default entry is a form (darmanitan-standard) are aliased by their species name
+	var speciesName string
+	err = s.db.QueryRow(`
+		SELECT COALESCE((SELECT alias FROM pokemon_aliases WHERE pokemon_name = $1 ORDER BY alias LIMIT 1), $1)
+	`, pokemon.Name).Scan(&speciesName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get species name: %w", err)
+	}
+
+	resource := func(kind, name string) map[string]string {
+		return map[string]string{"name": name, "url": fmt.Sprintf("%s/%s/%s/", pokeAPIBaseURL, kind, name)}
+	}
+
+	types := []map[string]interface{}{}
+	for _, t := range typesByPokemon[pokedexID] {
+		types = append(types, map[string]interface{}{
+			"slot": t.Slot,
+			"type": resource("type", t.TypeName),
+		})
+	}
+
+	abilities := []map[string]interface{}{}
+	for _, a := range abilitiesByPokemon[pokedexID] {
+		abilities = append(abilities, map[string]interface{}{
+			"ability":   resource("ability", a.AbilityName),
+			"is_hidden": a.IsHidden,
+			"slot":      a.Slot,
+		})
+	}
+
+	stats := []map[string]interface{}{}
+	if base := statsByPokemon[pokedexID]; base != nil {
+		for _, stat := range StatNames {
+			stats = append(stats, map[string]interface{}{
+				"base_stat": baseStat(base, stat),
+				"effort":    nil,
+				"stat":      resource("stat", strings.ReplaceAll(stat, "_", "-")),
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"id":                       pokemon.ID,
+		"name":                     pokemon.Name,
+		"base_experience":          nil,
+		"height":                   pokemon.Height,
+		"weight":                   pokemon.Weight,
+		"is_default":               true,
+		"order":                    nil,
+		"abilities":                abilities,
+		"forms":                    []map[string]string{resource("pokemon-form", pokemon.Name)},
+		"game_indices":             []interface{}{},
+		"held_items":               []interface{}{},
+		"location_area_encounters": fmt.Sprintf("%s/pokemon/%d/encounters", pokeAPIBaseURL, pokemon.ID),
+		"moves":                    []interface{}{},
+		"past_types":               []interface{}{},
+		"species":                  resource("pokemon-species", speciesName),
+		"sprites": map[string]interface{}{
+			"front_default":      nullableString(pokemon.SpriteURL),
+			"front_shiny":        nil,
+			"front_female":       nil,
+			"front_shiny_female": nil,
+			"back_default":       nil,
+			"back_shiny":         nil,
+			"back_female":        nil,
+			"back_shiny_female":  nil,
+			"versions": map[string]interface{}{
+				"generation-v": map[string]interface{}{
+					"black-white": map[string]interface{}{
+						"animated": map[string]interface{}{
+							"front_default": nullableString(pokemon.AnimatedFront),
+							"back_default":  nullableString(pokemon.AnimatedBack),
+						},
+					},
+				},
+			},
+		},
+		"stats": stats,
+		"types": types,
+	}, nil
+}
+
+// nullableString turns an empty string into a JSON null
+func nullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// ListPokemonResources returns one page of Pokedex IDs and names ordered by Pokedex ID, plus the total count
+func (s *PokemonService) ListPokemonResources(limit, offset int) ([]map[string]interface{}, int, error) {
+	rows, err := s.db.Query(`
+		SELECT pokedex_id, name, COUNT(*) OVER() AS total_count
+		FROM pokemon
+		ORDER BY pokedex_id
+		LIMIT $1 OFFSET $2
+	`, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query pokemon: %w", err)
+	}
+	defer rows.Close()
+
+	totalCount := 0
+	resources := []map[string]interface{}{}
+	for rows.Next() {
+		var pokedexID int
+		var name string
+		if err := rows.Scan(&pokedexID, &name, &totalCount); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan pokemon: %w", err)
+		}
+		resources = append(resources, map[string]interface{}{
+			"id":   pokedexID,
+			"name": name,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read pokemon: %w", err)
+	}
+
+	// A page past the end has no rows to carry the window count
+	if len(resources) == 0 && offset > 0 {
+		if err := s.db.QueryRow(`SELECT COUNT(*) FROM pokemon`).Scan(&totalCount); err != nil {
+			return nil, 0, fmt.Errorf("failed to get count: %w", err)
+		}
+	}
+
+	return resources, totalCount, nil
+}
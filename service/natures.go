@@ -0,0 +1,75 @@
+package service
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// Nature raises one stat by 10% and lowers another by 10%. Neutral natures name no stats.
+type Nature struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Increased string `json:"increased_stat,omitempty"`
+	Decreased string `json:"decreased_stat,omitempty"`
+}
+
+// neutralNature is used when a calculation names no nature
+var neutralNature = Nature{ID: 0, Name: "hardy"}
+
+// GetNatures returns all natures in their in-game order
+func (s *PokemonService) GetNatures() ([]Nature, error) {
+	rows, err := s.db.Query(`
+		SELECT id, name, COALESCE(increased_stat, ''), COALESCE(decreased_stat, '')
+		FROM natures
+		ORDER BY id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query natures: %w", err)
+	}
+	defer rows.Close()
+
+	natures := []Nature{}
+	for rows.Next() {
+		var n Nature
+		if err := rows.Scan(&n.ID, &n.Name, &n.Increased, &n.Decreased); err != nil {
+			return nil, fmt.Errorf("failed to scan nature: %w", err)
+		}
+		natures = append(natures, n)
+	}
+
+	return natures, rows.Err()
+}
+
+// lookupNature loads a nature by name; an empty name means a neutral nature
+func (s *PokemonService) lookupNature(name string) (Nature, error) {
+	name = NormalizeName(name)
+	if name == "" {
+		return neutralNature, nil
+	}
+
+	var n Nature
+	err := s.db.QueryRow(`
+		SELECT id, name, COALESCE(increased_stat, ''), COALESCE(decreased_stat, '')
+		FROM natures
+		WHERE name = $1
+	`, name).Scan(&n.ID, &n.Name, &n.Increased, &n.Decreased)
+	if err == sql.ErrNoRows {
+		return Nature{}, invalidArgument("nature", "unknown nature %q", name)
+	}
+	if err != nil {
+		return Nature{}, fmt.Errorf("failed to query nature: %w", err)
+	}
+
+	return n, nil
+}
+
+// modifier returns the nature's multiplier for a stat, in tenths (9, 10 or 11)
+func (n Nature) modifier(stat string) int {
+	switch {
+	case n.Increased != "" && stat == n.Increased:
+		return 11
+	case n.Decreased != "" && stat == n.Decreased:
+		return 9
+	}
+	return 10
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"pokeAPI/dto"
 	"time"
@@ -36,19 +37,28 @@ func (c *PokeAPIClient) FetchPokemon(id int) (*dto.PokeAPIResponse, error) {
 	
 	resp, err := c.httpClient.Get(url)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch pokemon %d: %w", id, err)
+		return nil, upstream(err, "failed to fetch pokemon %d", id)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("pokeapi returned status %d for pokemon %d", resp.StatusCode, id)
+		return nil, upstream(nil, "pokeapi returned status %d for pokemon %d", resp.StatusCode, id)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, upstream(err, "failed to read pokemon %d", id)
 	}
 
 	var pokemon dto.PokeAPIResponse
-	if err := json.NewDecoder(resp.Body).Decode(&pokemon); err != nil {
-		return nil, fmt.Errorf("failed to decode pokemon %d: %w", id, err)
+	if err := json.Unmarshal(body, &pokemon); err != nil {
+		return nil, upstream(err, "failed to decode pokemon %d", id)
 	}
 
+	// Keep the raw payload so it can be stored alongside the decoded columns
+	pokemon.Raw = body
+	pokemon.FetchedAt = time.Now()
+
 	return &pokemon, nil
 }
 
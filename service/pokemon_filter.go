@@ -0,0 +1,183 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// Type match modes for PokemonFilter.Types
+const (
+	TypeMatchAny = "any"
+	TypeMatchAll = "all"
+)
+
+// RangeFilterFields lists the fields that accept min/max bounds, mapped to their SQL expression
+var RangeFilterFields = map[string]string{
+	"height":          "p.height",
+	"weight":          "p.weight",
+	"hp":              "ps.hp",
+	"attack":          "ps.attack",
+	"defense":         "ps.defense",
+	"special_attack":  "ps.special_attack",
+	"special_defense": "ps.special_defense",
+	"speed":           "ps.speed",
+	"base_stat_total": baseStatTotalExpr,
+}
+
+// baseStatTotalExpr computes the base stat total from the joined pokemon_stats row
+const baseStatTotalExpr = "(ps.hp + ps.attack + ps.defense + ps.special_attack + ps.special_defense + ps.speed)"
+
+// sortColumns whitelists sortable fields (prevent SQL injection), mapped to their SQL expression.
+// Nullable columns are coalesced so keyset cursors never have to compare against NULL.
+var sortColumns = map[string]string{
+	"pokedex_id":      "p.pokedex_id",
+	"name":            "p.name",
+	"height":          "COALESCE(p.height, 0)",
+	"weight":          "COALESCE(p.weight, 0)",
+	"created_at":      "COALESCE(p.created_at, 'epoch'::timestamp)",
+	"hp":              "COALESCE(ps.hp, 0)",
+	"attack":          "COALESCE(ps.attack, 0)",
+	"defense":         "COALESCE(ps.defense, 0)",
+	"special_attack":  "COALESCE(ps.special_attack, 0)",
+	"special_defense": "COALESCE(ps.special_defense, 0)",
+	"speed":           "COALESCE(ps.speed, 0)",
+	"base_stat_total": "COALESCE(" + baseStatTotalExpr + ", 0)",
+}
+
+// pokemonFromClause is the base FROM clause every filtered list query runs against
+const pokemonFromClause = `
+	FROM pokemon p
+	LEFT JOIN pokemon_stats ps ON ps.pokemon_id = p.id
+`
+
+// PokemonFilter holds the list filters shared by every endpoint that selects a set of Pokemon
+type PokemonFilter struct {
+	Query         string         // fuzzy name search, see searchCondition
+	Types         []string       // match any or all of these types, see TypeMatch
+	TypeMatch     string         // TypeMatchAny (default) or TypeMatchAll
+	TypesExact    []string       // exact typing, in any order (e.g. grass + poison)
+	Ability       string         // has this ability, hidden or not
+	HiddenAbility string         // has this as its hidden ability
+	Min           map[string]int // lower bounds keyed by RangeFilterFields
+	Max           map[string]int // upper bounds keyed by RangeFilterFields
+	Generation    int            // Pokedex range of one generation, see GenerationRanges
+}
+
+// GenerationRanges maps each generation to its first and last Pokedex number
+var GenerationRanges = map[int][2]int{
+	1: {1, 151},
+	2: {152, 251},
+	3: {252, 386},
+	4: {387, 493},
+	5: {gen5StartID, gen5EndID},
+}
+
+// NormalizeName turns user input like "Solar Power" into PokeAPI's slug form "solar-power"
+func NormalizeName(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "-")
+}
+
+// conditions builds the WHERE conditions for the filter, appending bind values to args.
+// Placeholders continue numbering from the args already present.
+func (f PokemonFilter) conditions(args []interface{}) ([]string, []interface{}) {
+	var conds []string
+	next := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if q := NormalizeName(f.Query); q != "" {
+		conds = append(conds, searchCondition(next(likePrefix(q)), next(q)))
+	}
+
+	if types := normalizeNames(f.Types); len(types) > 0 {
+		if f.TypeMatch == TypeMatchAll {
+			conds = append(conds, fmt.Sprintf(`(
+				SELECT COUNT(DISTINCT pt.type_name) FROM pokemon_types pt
+				WHERE pt.pokemon_id = p.id AND pt.type_name = ANY(%s)
+			) = %d`, next(pq.Array(types)), len(types)))
+		} else {
+			conds = append(conds, fmt.Sprintf(`EXISTS (
+				SELECT 1 FROM pokemon_types pt
+				WHERE pt.pokemon_id = p.id AND pt.type_name = ANY(%s)
+			)`, next(pq.Array(types))))
+		}
+	}
+
+	if exact := normalizeNames(f.TypesExact); len(exact) > 0 {
+		sort.Strings(exact)
+		conds = append(conds, fmt.Sprintf(`ARRAY(
+			SELECT pt.type_name::text FROM pokemon_types pt
+			WHERE pt.pokemon_id = p.id
+			ORDER BY pt.type_name
+		) = %s::text[]`, next(pq.Array(exact))))
+	}
+
+	if ability := NormalizeName(f.Ability); ability != "" {
+		conds = append(conds, fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM pokemon_abilities pa
+			WHERE pa.pokemon_id = p.id AND pa.ability_name = %s
+		)`, next(ability)))
+	}
+
+	if hidden := NormalizeName(f.HiddenAbility); hidden != "" {
+		conds = append(conds, fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM pokemon_abilities pa
+			WHERE pa.pokemon_id = p.id AND pa.is_hidden AND pa.ability_name = %s
+		)`, next(hidden)))
+	}
+
+	if r, ok := GenerationRanges[f.Generation]; ok {
+		conds = append(conds, fmt.Sprintf("p.pokedex_id BETWEEN %s AND %s", next(r[0]), next(r[1])))
+	}
+
+	// Iterate in a stable order so identical filters produce identical SQL
+	for _, field := range sortedKeys(RangeFilterFields) {
+		expr := RangeFilterFields[field]
+		if min, ok := f.Min[field]; ok {
+			conds = append(conds, fmt.Sprintf("%s >= %s", expr, next(min)))
+		}
+		if max, ok := f.Max[field]; ok {
+			conds = append(conds, fmt.Sprintf("%s <= %s", expr, next(max)))
+		}
+	}
+
+	return conds, args
+}
+
+// whereClause joins the filter conditions into a WHERE clause, or returns "" when unfiltered
+func (f PokemonFilter) whereClause(args []interface{}) (string, []interface{}) {
+	conds, args := f.conditions(args)
+	if len(conds) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(conds, " AND "), args
+}
+
+// normalizeNames normalizes and de-duplicates a list of type or ability names
+func normalizeNames(names []string) []string {
+	seen := make(map[string]bool, len(names))
+	var out []string
+	for _, name := range names {
+		name = NormalizeName(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		out = append(out, name)
+	}
+	return out
+}
+
+// sortedKeys returns the keys of a string map in alphabetical order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -4,21 +4,33 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"pokeAPI/config"
 	"pokeAPI/dto"
 	"pokeAPI/model"
+	"time"
 )
 
 // PokemonService handles Pokemon business logic
 type PokemonService struct {
 	db            *sql.DB
 	pokeAPIClient *PokeAPIClient
+	syncJobs      *syncJobTracker
+	summaries     *summaryCache
+	reads         *readCache
+	versions      *versionCache
+	instanceID    string
 }
 
 // NewPokemonService creates a new Pokemon service
-func NewPokemonService(db *sql.DB) *PokemonService {
+func NewPokemonService(db *sql.DB, cfg *config.Config) *PokemonService {
 	return &PokemonService{
 		db:            db,
 		pokeAPIClient: NewPokeAPIClient(),
+		syncJobs:      newSyncJobTracker(),
+		summaries:     newSummaryCache(),
+		reads:         newReadCache(cfg.ReadCacheSize, time.Duration(cfg.ReadCacheTTLSeconds)*time.Second),
+		versions:      newVersionCache(),
+		instanceID:    newInstanceID(),
 	}
 }
 
@@ -56,7 +68,7 @@ func (s *PokemonService) SavePokemon(apiPokemon *dto.PokeAPIResponse) error {
     VALUES ($1, $2, $3, $4, $5, $6, $7)
     ON CONFLICT (pokedex_id) 
     DO UPDATE SET name = $2, height = $3, weight = $4, sprite_url = $5, 
-                  animated_front = $6, animated_back = $7
+                  animated_front = $6, animated_back = $7, updated_at = CURRENT_TIMESTAMP
     RETURNING id
 	`, apiPokemon.ID, apiPokemon.Name, apiPokemon.Height, apiPokemon.Weight, 
    spriteURL, animatedFront, animatedBack).Scan(&pokemonID)
@@ -117,16 +129,31 @@ func (s *PokemonService) SavePokemon(apiPokemon *dto.PokeAPIResponse) error {
 		return fmt.Errorf("failed to save stats: %w", err)
 	}
 
+	// Store the untouched upstream payload
+	if len(apiPokemon.Raw) > 0 {
+		if err := saveRawPayload(tx, pokemonID, apiPokemon); err != nil {
+			return err
+		}
+	}
+
 	// Commit transaction
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
+	// Cached reads and aggregates are stale once any Pokemon changes
+	s.invalidateReads()
+
 	return nil
 }
 
 // SyncGen5Pokemon fetches and saves all Gen 5 Pokemon
 func (s *PokemonService) SyncGen5Pokemon() error {
+	return s.syncGen5(nil)
+}
+
+// syncGen5 runs the Gen 5 sync, reporting progress to job when one is given
+func (s *PokemonService) syncGen5(job *syncJob) error {
 	log.Println("Starting Gen 5 Pokemon sync...")
 	
 	start, end := GetGen5Range()
@@ -139,21 +166,27 @@ func (s *PokemonService) SyncGen5Pokemon() error {
 		pokemon, err := s.pokeAPIClient.FetchPokemon(id)
 		if err != nil {
 			log.Printf("Warning: Failed to fetch pokemon %d: %v", id, err)
+			job.update(func(state *SyncJob) { state.Processed++; state.Failed++ })
 			continue
 		}
 		
 		if err := s.SavePokemon(pokemon); err != nil {
 			log.Printf("Warning: Failed to save pokemon %d: %v", id, err)
+			job.update(func(state *SyncJob) { state.Processed++; state.Failed++ })
 			continue
 		}
 
 		successCount++
 		log.Printf(" Saved %s (#%d)", pokemon.Name, pokemon.ID)
+		job.update(func(state *SyncJob) { state.Processed++; state.Saved++; state.Current = pokemon.Name })
 	}
 	// Update sync metadata
+	// Ranks first, so anything versioned by the new sync time sees them
+	s.refreshStatRanksAfterSync()
 	if err := s.updateSyncMetaData("gen5", successCount); err != nil {
 		log.Printf("Warning: Failed to update sync metadata: %v", err)
 	}
+	s.invalidateReads()
 
 	log.Printf(" Gen 5 sync complete! Saved %d/%d Pokemon", successCount, total)
 	return nil
@@ -161,7 +194,7 @@ func (s *PokemonService) SyncGen5Pokemon() error {
 
 
 // GetPokemonPaginated retrieves Pokemon with pagination, filtering, and sorting
-func (s *PokemonService) GetPokemonPaginated(limit, offset int, sortBy, order, typeFilter string) (map[string]interface{}, error) {
+func (s *PokemonService) GetPokemonPaginated(limit, offset int, sortBy, order string, filter PokemonFilter, include []string) (map[string]interface{}, error) {
 	// Validate and sanitize inputs
 	if limit <= 0 || limit > 100 {
 		limit = 20 // Default
@@ -170,137 +203,101 @@ func (s *PokemonService) GetPokemonPaginated(limit, offset int, sortBy, order, t
 		offset = 0
 	}
 	
-	// Whitelist allowed sort columns (prevent SQL injection)
-	allowedSortColumns := map[string]bool{
-		"pokedex_id": true,
-		"name":       true,
-		"height":     true,
-		"weight":     true,
-		"created_at": true,
-	}
-	if !allowedSortColumns[sortBy] {
-		sortBy = "pokedex_id" // Default
-	}
-	
 	// Validate order
 	if order != "asc" && order != "desc" {
 		order = "asc" // Default
 	}
 	
-	// Build query with optional type filter
-	var query string
-	var countQuery string
-	var args []interface{}
-	var countArgs []interface{}
-	
-	if typeFilter != "" {
-		// Filter by type
-		query = `
-			SELECT p.id, p.pokedex_id, p.name, p.height, p.weight, p.sprite_url, 
-           	p.animated_front, p.animated_back, p.created_at
-    		FROM pokemon p
-			INNER JOIN pokemon_types pt ON p.id = pt.pokemon_id
-			WHERE pt.type_name = $1
-			ORDER BY p.` + sortBy + ` ` + order + `
-			LIMIT $2 OFFSET $3
-		`
-		countQuery = `
-			SELECT COUNT(DISTINCT p.id)
-			FROM pokemon p
-			INNER JOIN pokemon_types pt ON p.id = pt.pokemon_id
-			WHERE pt.type_name = $1
-		`
-		args = []interface{}{typeFilter, limit, offset}
-		countArgs = []interface{}{typeFilter}
-	} else {
-		// No filter
-		query = `
-			SELECT p.id, p.pokedex_id, p.name, p.height, p.weight, p.sprite_url, 
-           	p.animated_front, p.animated_back, p.created_at
-    		FROM pokemon p
-			ORDER BY p.` + sortBy + ` ` + order + `
-			LIMIT $1 OFFSET $2
-		`
-		countQuery = `SELECT COUNT(*) FROM pokemon`
-		args = []interface{}{limit, offset}
-		countArgs = []interface{}{}
+	cacheKey := fmt.Sprintf("list|%d|%d|%s|%s|%s|%s", limit, offset, sortBy, order, filter.cacheKey(), includeKey(include))
+	cached, generation, ok := s.reads.get(cacheKey)
+	if ok {
+		return cached.(map[string]interface{}), nil
 	}
 	
-	// Get total count
-	var totalCount int
-	err := s.db.QueryRow(countQuery, countArgs...).Scan(&totalCount)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get count: %w", err)
+	// Build query with filters.
+	// COUNT(*) OVER() returns the filtered total on every row, so the count
+	// and the page come back in a single round trip.
+	where, args := filter.whereClause(nil)
+	countArgs := args
+	
+	// Whitelist allowed sort columns (prevent SQL injection)
+	sortExpr, ok := sortColumns[sortBy]
+	if q := NormalizeName(filter.Query); sortBy == SortRelevance && q != "" {
+		args = append(args, likePrefix(q), q)
+		sortExpr = searchRankExpr(fmt.Sprintf("$%d", len(args)-1), fmt.Sprintf("$%d", len(args)))
+	} else if !ok {
+		sortBy = "pokedex_id"
+		sortExpr = sortColumns[sortBy] // Default
 	}
 	
-	// Get paginated data
+	query := `
+		SELECT ` + pokemonListColumns + `, (` + sortExpr + `)::text AS sort_key, COUNT(*) OVER() AS total_count
+	` + pokemonFromClause + where + `
+		ORDER BY ` + sortExpr + ` ` + order + ` NULLS LAST, p.id ` + order + `
+		LIMIT ` + fmt.Sprintf("$%d OFFSET $%d", len(args)+1, len(args)+2)
+	args = append(args, limit, offset)
+	
+	// Get paginated data together with the total count
 	rows, err := s.db.Query(query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query pokemon: %w", err)
 	}
-	defer rows.Close()
+	page, totalCount, err := scanPokemonList(rows, true)
+	if err != nil {
+		return nil, err
+	}
 	
-	var pokemons []map[string]interface{}
-	for rows.Next() {
-		var id, pokedexID, height, weight int
-		var name, spriteURL, animatedFront, animatedBack, createdAt string
-		
-		err := rows.Scan(&id, &pokedexID, &name, &height, &weight, &spriteURL, &animatedFront, &animatedBack, &createdAt)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan pokemon: %w", err)
-		}
-		
-		// Get types for this pokemon
-		typesRows, err := s.db.Query(`
-			SELECT type_name FROM pokemon_types
-			WHERE pokemon_id = $1
-			ORDER BY slot
-		`, id)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get types: %w", err)
-		}
-		
-		var types []string
-		for typesRows.Next() {
-			var typeName string
-			if err := typesRows.Scan(&typeName); err != nil {
-				typesRows.Close()
-				return nil, err
-			}
-			types = append(types, typeName)
+	// A page past the end has no rows to carry the window count, so ask for it directly
+	if len(page) == 0 && offset > 0 {
+		countQuery := `SELECT COUNT(*) ` + pokemonFromClause + where
+		if err := s.db.QueryRow(countQuery, countArgs...).Scan(&totalCount); err != nil {
+			return nil, fmt.Errorf("failed to get count: %w", err)
 		}
-		typesRows.Close()
-		
-		pokemons = append(pokemons, map[string]interface{}{
-    	"id":             pokedexID,
-    	"name":           name,
-    	"height":         height,
-    	"weight":         weight,
-    	"sprite_url":     spriteURL,
-    	"animated_front": animatedFront,
-    	"animated_back":  animatedBack,
-    	"types":          types,
-    	"created_at":     createdAt,
-		})
+	}
+	
+	// Load types and includes for the whole page in one query each instead of one per row
+	pokemons, err := s.expandPage(page, include)
+	if err != nil {
+		return nil, err
 	}
 	
 	// Calculate pagination info
 	totalPages := (totalCount + limit - 1) / limit
 	currentPage := (offset / limit) + 1
+	hasNext := offset+limit < totalCount
 	
-	return map[string]interface{}{
+	result := map[string]interface{}{
 		"data":         pokemons,
 		"total":        totalCount,
 		"page":         currentPage,
 		"limit":        limit,
 		"total_pages":  totalPages,
-		"has_next":     offset+limit < totalCount,
+		"has_next":     hasNext,
 		"has_previous": offset > 0,
-	}, nil
+	}
+	
+	// Hand out cursors so clients can switch to keyset paging from any page
+	if sortBy != SortRelevance && len(page) > 0 {
+		if hasNext {
+			result["next_cursor"] = encodeCursor(pageCursor{Sort: sortBy, Order: order, Key: page[len(page)-1].sortKey, ID: page[len(page)-1].id})
+		}
+		if offset > 0 {
+			result["prev_cursor"] = encodeCursor(pageCursor{Sort: sortBy, Order: order, Key: page[0].sortKey, ID: page[0].id, Prev: true})
+		}
+	}
+	
+	s.reads.put(cacheKey, result, generation)
+	return result, nil
 }
 
 // GetPokemonByID retrieves a single Pokemon by its Pokedex ID
 func (s *PokemonService) GetPokemonByID(pokedexID int) (*model.Pokemon, error) {
+	cacheKey := fmt.Sprintf("pokemon|%d", pokedexID)
+	cached, generation, ok := s.reads.get(cacheKey)
+	if ok {
+		return cached.(*model.Pokemon), nil
+	}
+
 	var p model.Pokemon
 	var dbID int
 	err := s.db.QueryRow(`
@@ -311,12 +308,13 @@ func (s *PokemonService) GetPokemonByID(pokedexID int) (*model.Pokemon, error) {
                    &p.AnimatedFront, &p.AnimatedBack, &p.CreatedAt)
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("pokemon with pokedex id %d not found", pokedexID)
+		return nil, notFound("pokemon with pokedex id %d not found", pokedexID)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to query pokemon: %w", err)
 	}
 
+	s.reads.put(cacheKey, &p, generation)
 	return &p, nil
 }
 
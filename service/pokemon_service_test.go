@@ -0,0 +1,70 @@
+package service
+
+import (
+	"fmt"
+	"pokeAPI/config"
+	"sort"
+	"testing"
+	"time"
+)
+
+// benchmarkService connects to the database from the usual DB_* settings, with the read cache
+// off so every call reaches Postgres. Benchmarks are skipped when no synced database is reachable.
+func benchmarkService(b *testing.B) *PokemonService {
+	b.Helper()
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		b.Skipf("no config: %v", err)
+	}
+	cfg.ReadCacheSize = 0
+
+	db, err := config.ConnectDatabase(cfg)
+	if err != nil {
+		b.Skipf("no database: %v", err)
+	}
+	b.Cleanup(func() { db.Close() })
+
+	var count int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM pokemon`).Scan(&count); err != nil || count == 0 {
+		b.Skip("no synced pokemon; run POST /api/pokemon/sync first")
+	}
+
+	return NewPokemonService(db, cfg)
+}
+
+// BenchmarkGetPokemonPaginated pages through the whole list at several page sizes and reports
+// the p95 latency of a page, which should stay flat as limit grows
+func BenchmarkGetPokemonPaginated(b *testing.B) {
+	s := benchmarkService(b)
+
+	for _, limit := range []int{10, 25, 50, 100} {
+		b.Run(fmt.Sprintf("limit=%d", limit), func(b *testing.B) {
+			var durations []time.Duration
+			for i := 0; i < b.N; i++ {
+				for offset := 0; ; offset += limit {
+					start := time.Now()
+					result, err := s.GetPokemonPaginated(limit, offset, "pokedex_id", "asc", PokemonFilter{}, nil)
+					if err != nil {
+						b.Fatal(err)
+					}
+					durations = append(durations, time.Since(start))
+
+					if hasNext, _ := result["has_next"].(bool); !hasNext {
+						break
+					}
+				}
+			}
+			b.ReportMetric(float64(percentile(durations, 0.95).Microseconds()), "p95-us/page")
+		})
+	}
+}
+
+// percentile returns the p-th percentile (0-1) of durations
+func percentile(durations []time.Duration, p float64) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	return durations[int(float64(len(durations)-1)*p)]
+}
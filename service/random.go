@@ -0,0 +1,132 @@
+package service
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// MaxRandomCount bounds how many Pokemon one random draw may return
+const MaxRandomCount = 50
+
+// NewSeed returns a fresh random seed for clients that didn't send one
+func NewSeed() string {
+	b := make([]byte, 6)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// GetRandomPokemon draws count Pokemon matching the filter. The draw is a pure function of the seed
+// and the stored data, so the same seed returns the same Pokemon in the same order.
+func (s *PokemonService) GetRandomPokemon(count int, seed string, filter PokemonFilter, include []string) ([]map[string]interface{}, error) {
+	if count <= 0 {
+		count = 1
+	}
+	if count > MaxRandomCount {
+		count = MaxRandomCount
+	}
+
+	where, args := filter.whereClause(nil)
+	args = append(args, seed, count)
+
+	rows, err := s.db.Query(`
+		SELECT `+pokemonListColumns+`, ''::text AS sort_key
+	`+pokemonFromClause+where+`
+		ORDER BY md5(`+fmt.Sprintf("$%d", len(args)-1)+`::text || ':' || p.pokedex_id), p.id
+		LIMIT `+fmt.Sprintf("$%d", len(args)), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pokemon: %w", err)
+	}
+	page, _, err := scanPokemonList(rows, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.expandPage(page, include)
+}
+
+// GetDailyPokemon returns the Pokemon of the day for a calendar date. The first request for a date
+// picks it and stores it, so it stays stable; Pokemon picked within noRepeatDays of the date are skipped.
+// Only dates from noRepeatDays before today up to tomorrow (UTC, a day of slack for time zones) are
+// stored, so arbitrary dates can't grow the table; older dates are picked the same way without storing,
+// and later dates are rejected.
+func (s *PokemonService) GetDailyPokemon(date time.Time, noRepeatDays int, include []string) (map[string]interface{}, error) {
+	if noRepeatDays < 0 {
+		noRepeatDays = 0
+	}
+	day := date.Format("2006-01-02")
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	requested := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	latest := today.AddDate(0, 0, 1)
+	if requested.After(latest) {
+		return nil, invalidArgument("date", "date can't be later than %s", latest.Format("2006-01-02"))
+	}
+	store := !requested.Before(today.AddDate(0, 0, -noRepeatDays-1))
+
+	var pokedexID int
+	err := s.db.QueryRow(`SELECT pokedex_id FROM daily_pokemon WHERE day = $1`, day).Scan(&pokedexID)
+	if err == sql.ErrNoRows {
+		pokedexID, err = s.pickDailyPokemon(day, noRepeatDays, store)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	pokemon, err := s.GetPokemonDetail(pokedexID, include)
+	if err != nil {
+		return nil, err
+	}
+	pokemon["date"] = day
+	return pokemon, nil
+}
+
+// dailyCandidateQuery picks the Pokemon for day $1, preferring Pokemon not picked within $2 days either side.
+// Candidates are ordered by a hash of the day so the pick doesn't depend on which replica runs it.
+const dailyCandidateQuery = `
+	SELECT p.pokedex_id
+	FROM pokemon p
+	ORDER BY (p.pokedex_id IN (
+		SELECT d.pokedex_id FROM daily_pokemon d
+		WHERE d.day BETWEEN $1::date - $2::int AND $1::date + $2::int
+	)), md5('daily:' || $1::date::text || ':' || p.pokedex_id)
+	LIMIT 1`
+
+// pickDailyPokemon picks the Pokemon for a day, storing the pick when store is set
+func (s *PokemonService) pickDailyPokemon(day string, noRepeatDays int, store bool) (int, error) {
+	var pokedexID int
+	if !store {
+		err := s.db.QueryRow(dailyCandidateQuery, day, noRepeatDays).Scan(&pokedexID)
+		if err == sql.ErrNoRows {
+			return 0, notFound("daily pokemon for %s not found, no pokemon synced yet", day)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to pick daily pokemon: %w", err)
+		}
+		return pokedexID, nil
+	}
+
+	// Insert-or-keep, so concurrent first requests agree on one pick
+	_, err := s.db.Exec(`
+		INSERT INTO daily_pokemon (day, pokedex_id)
+		SELECT $1::date, pick.pokedex_id
+		FROM (`+dailyCandidateQuery+`) pick
+		ON CONFLICT (day) DO NOTHING
+	`, day, noRepeatDays)
+	if err != nil {
+		return 0, fmt.Errorf("failed to pick daily pokemon: %w", err)
+	}
+
+	err = s.db.QueryRow(`SELECT pokedex_id FROM daily_pokemon WHERE day = $1`, day).Scan(&pokedexID)
+	if err == sql.ErrNoRows {
+		return 0, notFound("daily pokemon for %s not found, no pokemon synced yet", day)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to query daily pokemon: %w", err)
+	}
+
+	return pokedexID, nil
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/lib/pq"
+)
+
+// IncludeRanks embeds each stat's rank and percentile, overall and within the Pokemon's types
+const IncludeRanks = "ranks"
+
+// RankedStats lists the stats leaderboards and ranks cover: the six base stats and their total
+var RankedStats = append(append([]string{}, StatNames...), "base_stat_total")
+
+// Leaderboard size limits
+const (
+	defaultLeaderboardLimit = 10
+	maxLeaderboardLimit     = 100
+)
+
+// RefreshStatRanks recomputes the pokemon_stat_ranks materialized view. Reads keep using the
+// previous ranks until the refresh completes.
+func (s *PokemonService) RefreshStatRanks() error {
+	if _, err := s.db.Exec(`REFRESH MATERIALIZED VIEW CONCURRENTLY pokemon_stat_ranks`); err != nil {
+		return fmt.Errorf("failed to refresh stat ranks: %w", err)
+	}
+	return nil
+}
+
+// refreshStatRanksAfterSync refreshes ranks once a sync or backfill has saved its Pokemon
+func (s *PokemonService) refreshStatRanksAfterSync() {
+	if err := s.RefreshStatRanks(); err != nil {
+		log.Printf("Warning: %v", err)
+	}
+}
+
+// GetLeaderboard ranks Pokemon by a stat from RankedStats, optionally only among one type.
+// Tied Pokemon share a rank.
+func (s *PokemonService) GetLeaderboard(stat, typeName string, limit int) ([]map[string]interface{}, error) {
+	if !containsString(RankedStats, stat) {
+		return nil, invalidArgument("stat", "unknown stat %q", stat)
+	}
+	if limit <= 0 {
+		limit = defaultLeaderboardLimit
+	}
+	if limit > maxLeaderboardLimit {
+		limit = maxLeaderboardLimit
+	}
+
+	rows, err := s.db.Query(`
+		SELECT p.pokedex_id, p.name, p.sprite_url, r.value, r.rank, r.total, r.percentile
+		FROM pokemon_stat_ranks r
+		INNER JOIN pokemon p ON p.pokedex_id = r.pokedex_id
+		WHERE r.stat = $1 AND r.scope = $2
+		ORDER BY r.rank, p.pokedex_id
+		LIMIT $3
+	`, stat, NormalizeName(typeName), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query leaderboard: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []map[string]interface{}{}
+	var ids []int
+	for rows.Next() {
+		var pokedexID, value, rank, total int
+		var name, spriteURL string
+		var percentile float64
+		if err := rows.Scan(&pokedexID, &name, &spriteURL, &value, &rank, &total, &percentile); err != nil {
+			return nil, fmt.Errorf("failed to scan leaderboard: %w", err)
+		}
+		ids = append(ids, pokedexID)
+		entries = append(entries, map[string]interface{}{
+			"rank":       rank,
+			"id":         pokedexID,
+			"name":       name,
+			"sprite_url": spriteURL,
+			"value":      value,
+			"total":      total,
+			"percentile": percentile,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read leaderboard: %w", err)
+	}
+
+	typesByPokemon, err := s.TypesByPokedexID(ids)
+	if err != nil {
+		return nil, err
+	}
+	for i, entry := range entries {
+		names := []string{}
+		for _, t := range typesByPokemon[ids[i]] {
+			names = append(names, t.TypeName)
+		}
+		entry["types"] = names
+	}
+
+	return entries, nil
+}
+
+// ranksByPokedexID batch-loads precomputed ranks for a set of Pokedex IDs, keyed by stat.
+// Each stat holds the overall rank plus a by_type entry for each of the Pokemon's types.
+func (s *PokemonService) ranksByPokedexID(pokedexIDs []int) (map[int]map[string]interface{}, error) {
+	ranksByPokemon := make(map[int]map[string]interface{}, len(pokedexIDs))
+	if len(pokedexIDs) == 0 {
+		return ranksByPokemon, nil
+	}
+
+	rows, err := s.db.Query(`
+		SELECT pokedex_id, stat, scope, rank, total, percentile
+		FROM pokemon_stat_ranks
+		WHERE pokedex_id = ANY($1)
+		ORDER BY pokedex_id, stat, scope
+	`, pq.Array(pokedexIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ranks: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pokedexID, rank, total int
+		var stat, scope string
+		var percentile float64
+		if err := rows.Scan(&pokedexID, &stat, &scope, &rank, &total, &percentile); err != nil {
+			return nil, fmt.Errorf("failed to scan rank: %w", err)
+		}
+
+		ranks, ok := ranksByPokemon[pokedexID]
+		if !ok {
+			ranks = map[string]interface{}{}
+			ranksByPokemon[pokedexID] = ranks
+		}
+		statRanks, ok := ranks[stat].(map[string]interface{})
+		if !ok {
+			statRanks = map[string]interface{}{"by_type": map[string]interface{}{}}
+			ranks[stat] = statRanks
+		}
+
+		rankInfo := map[string]interface{}{"rank": rank, "total": total, "percentile": percentile}
+		if scope == "" {
+			for key, value := range rankInfo {
+				statRanks[key] = value
+			}
+		} else {
+			statRanks["by_type"].(map[string]interface{})[scope] = rankInfo
+		}
+	}
+
+	return ranksByPokemon, rows.Err()
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"pokeAPI/dto"
+	"time"
+)
+
+// saveRawPayload upserts the raw PokeAPI payload for a Pokemon inside an open transaction. The hash is of
+// the bytes as fetched, so it identifies the upstream response even though JSONB reorders keys.
+func saveRawPayload(tx *sql.Tx, pokemonID int, apiPokemon *dto.PokeAPIResponse) error {
+	fetchedAt := apiPokemon.FetchedAt
+	if fetchedAt.IsZero() {
+		fetchedAt = time.Now()
+	}
+
+	sum := sha256.Sum256(apiPokemon.Raw)
+	hash := hex.EncodeToString(sum[:])
+
+	_, err := tx.Exec(`
+		INSERT INTO pokemon_raw (pokemon_id, payload, payload_hash, fetched_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (pokemon_id)
+		DO UPDATE SET payload = $2, payload_hash = $3, fetched_at = $4
+	`, pokemonID, []byte(apiPokemon.Raw), hash, fetchedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to save raw payload: %w", err)
+	}
+
+	return nil
+}
+
+// GetRawPayload retrieves the stored upstream payload for a Pokemon by its Pokedex ID
+func (s *PokemonService) GetRawPayload(pokedexID int) (map[string]interface{}, error) {
+	var payload []byte
+	var hash string
+	var fetchedAt time.Time
+
+	err := s.db.QueryRow(`
+		SELECT r.payload, r.payload_hash, r.fetched_at
+		FROM pokemon_raw r
+		INNER JOIN pokemon p ON p.id = r.pokemon_id
+		WHERE p.pokedex_id = $1
+	`, pokedexID).Scan(&payload, &hash, &fetchedAt)
+
+	if err == sql.ErrNoRows {
+		return nil, notFound("raw payload for pokedex id %d not found", pokedexID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query raw payload: %w", err)
+	}
+
+	return map[string]interface{}{
+		"id":           pokedexID,
+		"payload_hash": hash,
+		"fetched_at":   fetchedAt,
+		"payload":      json.RawMessage(payload),
+	}, nil
+}
+
+// BackfillFromRawPayloads re-derives every Pokemon's columns from its stored payload without calling PokeAPI
+func (s *PokemonService) BackfillFromRawPayloads() (int, error) {
+	rows, err := s.db.Query(`SELECT payload, fetched_at FROM pokemon_raw ORDER BY pokemon_id`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query raw payloads: %w", err)
+	}
+
+	// Collect first so the saves below don't compete with an open cursor
+	var pokemons []*dto.PokeAPIResponse
+	for rows.Next() {
+		var payload []byte
+		var fetchedAt time.Time
+		if err := rows.Scan(&payload, &fetchedAt); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan raw payload: %w", err)
+		}
+
+		var pokemon dto.PokeAPIResponse
+		if err := json.Unmarshal(payload, &pokemon); err != nil {
+			log.Printf("Warning: Failed to decode stored payload: %v", err)
+			continue
+		}
+		pokemon.Raw = payload
+		pokemon.FetchedAt = fetchedAt
+
+		pokemons = append(pokemons, &pokemon)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to read raw payloads: %w", err)
+	}
+
+	successCount := 0
+	for _, pokemon := range pokemons {
+		if err := s.SavePokemon(pokemon); err != nil {
+			log.Printf("Warning: Failed to backfill pokemon %d: %v", pokemon.ID, err)
+			continue
+		}
+		successCount++
+	}
+
+	s.refreshStatRanksAfterSync()
+	s.invalidateReads()
+
+	log.Printf(" Backfill complete! Rebuilt %d/%d Pokemon from stored payloads", successCount, len(pokemons))
+	return successCount, nil
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"container/list"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"pokeAPI/model"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// invalidationChannel is the Postgres NOTIFY channel replicas use to tell each other to drop cached reads
+const invalidationChannel = "pokemon_cache_invalidate"
+
+// readCache is a size-bounded LRU with a TTL, sitting in front of the list and detail queries.
+// A size of 0 disables it.
+type readCache struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	order    *list.List // front is most recently used
+	items    map[string]*list.Element
+	// generation is bumped on every purge, so a read that started before a purge can't
+	// store its stale result after it
+	generation uint64
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+type readCacheEntry struct {
+	key     string
+	value   interface{}
+	expires time.Time
+}
+
+func newReadCache(capacity int, ttl time.Duration) *readCache {
+	return &readCache{
+		capacity: capacity,
+		ttl:      ttl,
+		order:    list.New(),
+		items:    map[string]*list.Element{},
+	}
+}
+
+// get returns a copy of the cached value, safe for the caller to modify at the top level.
+// On a miss it returns the current generation, to be passed to put with the loaded value.
+func (c *readCache) get(key string) (interface{}, uint64, bool) {
+	if c.capacity <= 0 {
+		return nil, 0, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if ok {
+		entry := elem.Value.(*readCacheEntry)
+		if c.ttl <= 0 || time.Now().Before(entry.expires) {
+			c.order.MoveToFront(elem)
+			c.hits.Add(1)
+			return cloneCached(entry.value), c.generation, true
+		}
+		c.order.Remove(elem)
+		delete(c.items, key)
+	}
+
+	c.misses.Add(1)
+	return nil, c.generation, false
+}
+
+// put stores a copy of value loaded at generation, evicting the least recently used entry when full.
+// Values loaded before the latest purge are dropped.
+func (c *readCache) put(key string, value interface{}, generation uint64) {
+	if c.capacity <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	entry := &readCacheEntry{key: key, value: cloneCached(value), expires: time.Now().Add(c.ttl)}
+	if elem, ok := c.items[key]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*readCacheEntry).key)
+	}
+}
+
+// purge drops every entry; counters are kept
+func (c *readCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.order.Init()
+	c.items = map[string]*list.Element{}
+	c.generation++
+}
+
+func (c *readCache) stats() map[string]interface{} {
+	c.mu.Lock()
+	size := c.order.Len()
+	c.mu.Unlock()
+
+	hits, misses := c.hits.Load(), c.misses.Load()
+	ratio := 0.0
+	if hits+misses > 0 {
+		ratio = float64(hits) / float64(hits+misses)
+	}
+	return map[string]interface{}{
+		"enabled":     c.capacity > 0,
+		"size":        size,
+		"capacity":    c.capacity,
+		"ttl_seconds": c.ttl.Seconds(),
+		"hits":        hits,
+		"misses":      misses,
+		"hit_ratio":   ratio,
+	}
+}
+
+// cloneCached copies a cached result deep enough that callers can replace fields and list entries.
+// Nested values below that (types, stats, ...) are shared and must not be modified.
+func cloneCached(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *model.Pokemon:
+		copied := *v
+		return &copied
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for key, field := range v {
+			if rows, ok := field.([]map[string]interface{}); ok {
+				copiedRows := make([]map[string]interface{}, len(rows))
+				for i, row := range rows {
+					copiedRows[i] = cloneCached(row).(map[string]interface{})
+				}
+				field = copiedRows
+			}
+			copied[key] = field
+		}
+		return copied
+	}
+	return value
+}
+
+// cacheKey normalizes the filter so equivalent requests share cache entries
+func (f PokemonFilter) cacheKey() string {
+	types := normalizeNames(f.Types)
+	sort.Strings(types)
+	exact := normalizeNames(f.TypesExact)
+	sort.Strings(exact)
+	match := f.TypeMatch
+	if match != TypeMatchAll {
+		match = TypeMatchAny
+	}
+
+	// fmt prints maps with sorted keys, so bounds serialize stably
+	return fmt.Sprintf("q=%s|types=%s|match=%s|exact=%s|ability=%s|hidden=%s|gen=%d|min=%v|max=%v",
+		NormalizeName(f.Query), strings.Join(types, ","), match, strings.Join(exact, ","),
+		NormalizeName(f.Ability), NormalizeName(f.HiddenAbility), f.Generation, f.Min, f.Max)
+}
+
+// includeKey normalizes an include list for cache keys
+func includeKey(include []string) string {
+	sorted := append([]string{}, include...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
+
+// ReadCacheStats reports the read cache's size and hit/miss counters
+func (s *PokemonService) ReadCacheStats() map[string]interface{} {
+	return s.reads.stats()
+}
+
+// invalidateReads drops every cached read here and tells other replicas to do the same
+func (s *PokemonService) invalidateReads() {
+	s.purgeLocalReads()
+	if _, err := s.db.Exec(`SELECT pg_notify($1, $2)`, invalidationChannel, s.instanceID); err != nil {
+		log.Printf("Warning: Failed to notify cache invalidation: %v", err)
+	}
+}
+
+func (s *PokemonService) purgeLocalReads() {
+	s.reads.purge()
+	s.summaries.invalidate()
+	s.versions.invalidate()
+}
+
+// ListenForInvalidations subscribes to invalidations sent by other replicas over Postgres
+// LISTEN/NOTIFY. Everything is purged after a reconnect, since notifications may have been missed.
+func (s *PokemonService) ListenForInvalidations(connStr string) error {
+	listener := pq.NewListener(connStr, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("Warning: Cache invalidation listener: %v", err)
+		}
+	})
+	if err := listener.Listen(invalidationChannel); err != nil {
+		listener.Close()
+		return fmt.Errorf("failed to listen for cache invalidations: %w", err)
+	}
+
+	go func() {
+		for notification := range listener.Notify {
+			if notification == nil || notification.Extra != s.instanceID {
+				s.purgeLocalReads()
+			}
+		}
+	}()
+	return nil
+}
+
+// newInstanceID identifies this process in invalidation notifications, so it can skip its own
+func newInstanceID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"pokeAPI/model"
+
+	"github.com/lib/pq"
+)
+
+// Related data that can be embedded with ?include=
+const (
+	IncludeAbilities = "abilities"
+	IncludeStats     = "stats"
+	IncludeSpecies   = "species"
+)
+
+// ValidIncludes lists every relation accepted by ?include=
+var ValidIncludes = []string{IncludeAbilities, IncludeStats, IncludeSpecies, IncludeRanks}
+
+// expandPage batch-loads types plus any requested includes for a page and returns its rows as response maps.
+// Each relation costs one query for the whole page, regardless of page size.
+func (s *PokemonService) expandPage(page []listRow, include []string) ([]map[string]interface{}, error) {
+	ids := make([]int, len(page))
+	for i, row := range page {
+		ids[i] = row.pokedexID
+	}
+
+	typesByPokemon, err := s.TypesByPokedexID(ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range page {
+		var names []string
+		for _, t := range typesByPokemon[row.pokedexID] {
+			names = append(names, t.TypeName)
+		}
+		row.data["types"] = names
+	}
+
+	for _, relation := range include {
+		switch relation {
+		case IncludeAbilities:
+			abilitiesByPokemon, err := s.AbilitiesByPokedexID(ids)
+			if err != nil {
+				return nil, err
+			}
+			for _, row := range page {
+				abilities := []map[string]interface{}{}
+				for _, a := range abilitiesByPokemon[row.pokedexID] {
+					abilities = append(abilities, map[string]interface{}{
+						"name":      a.AbilityName,
+						"is_hidden": a.IsHidden,
+						"slot":      a.Slot,
+					})
+				}
+				row.data[relation] = abilities
+			}
+		case IncludeStats:
+			statsByPokemon, err := s.StatsByPokedexID(ids)
+			if err != nil {
+				return nil, err
+			}
+			for _, row := range page {
+				if stats := statsByPokemon[row.pokedexID]; stats != nil {
+					row.data[relation] = statsResponse(stats)
+				} else {
+					row.data[relation] = nil
+				}
+			}
+		case IncludeSpecies:
+			speciesByPokemon, err := s.speciesByPokedexID(ids)
+			if err != nil {
+				return nil, err
+			}
+			for _, row := range page {
+				row.data[relation] = speciesByPokemon[row.pokedexID]
+			}
+		case IncludeRanks:
+			ranksByPokemon, err := s.ranksByPokedexID(ids)
+			if err != nil {
+				return nil, err
+			}
+			for _, row := range page {
+				row.data[relation] = ranksByPokemon[row.pokedexID]
+			}
+		}
+	}
+
+	pokemons := make([]map[string]interface{}, len(page))
+	for i, row := range page {
+		pokemons[i] = row.data
+	}
+	return pokemons, nil
+}
+
+// statsResponse flattens base stats into the response shape, adding the base stat total
+func statsResponse(stats *model.PokemonStats) map[string]interface{} {
+	return map[string]interface{}{
+		"hp":              stats.HP,
+		"attack":          stats.Attack,
+		"defense":         stats.Defense,
+		"special_attack":  stats.SpecialAttack,
+		"special_defense": stats.SpecialDefense,
+		"speed":           stats.Speed,
+		"base_stat_total": stats.HP + stats.Attack + stats.Defense + stats.SpecialAttack + stats.SpecialDefense + stats.Speed,
+	}
+}
+
+// TypesByPokedexID batch-loads types for a set of Pokedex IDs, ordered by slot
+func (s *PokemonService) TypesByPokedexID(pokedexIDs []int) (map[int][]model.PokemonType, error) {
+	typesByPokemon := make(map[int][]model.PokemonType, len(pokedexIDs))
+	if len(pokedexIDs) == 0 {
+		return typesByPokemon, nil
+	}
+
+	rows, err := s.db.Query(`
+		SELECT p.pokedex_id, pt.id, pt.pokemon_id, pt.type_name, pt.slot
+		FROM pokemon_types pt
+		INNER JOIN pokemon p ON p.id = pt.pokemon_id
+		WHERE p.pokedex_id = ANY($1)
+		ORDER BY p.pokedex_id, pt.slot
+	`, pq.Array(pokedexIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get types: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pokedexID int
+		var t model.PokemonType
+		if err := rows.Scan(&pokedexID, &t.ID, &t.PokemonID, &t.TypeName, &t.Slot); err != nil {
+			return nil, fmt.Errorf("failed to scan type: %w", err)
+		}
+		typesByPokemon[pokedexID] = append(typesByPokemon[pokedexID], t)
+	}
+
+	return typesByPokemon, rows.Err()
+}
+
+// AbilitiesByPokedexID batch-loads abilities for a set of Pokedex IDs, ordered by slot
+func (s *PokemonService) AbilitiesByPokedexID(pokedexIDs []int) (map[int][]model.PokemonAbility, error) {
+	abilitiesByPokemon := make(map[int][]model.PokemonAbility, len(pokedexIDs))
+	if len(pokedexIDs) == 0 {
+		return abilitiesByPokemon, nil
+	}
+
+	rows, err := s.db.Query(`
+		SELECT p.pokedex_id, pa.id, pa.pokemon_id, pa.ability_name, pa.is_hidden, pa.slot
+		FROM pokemon_abilities pa
+		INNER JOIN pokemon p ON p.id = pa.pokemon_id
+		WHERE p.pokedex_id = ANY($1)
+		ORDER BY p.pokedex_id, pa.slot
+	`, pq.Array(pokedexIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get abilities: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pokedexID int
+		var a model.PokemonAbility
+		if err := rows.Scan(&pokedexID, &a.ID, &a.PokemonID, &a.AbilityName, &a.IsHidden, &a.Slot); err != nil {
+			return nil, fmt.Errorf("failed to scan ability: %w", err)
+		}
+		abilitiesByPokemon[pokedexID] = append(abilitiesByPokemon[pokedexID], a)
+	}
+
+	return abilitiesByPokemon, rows.Err()
+}
+
+// StatsByPokedexID batch-loads base stats for a set of Pokedex IDs
+func (s *PokemonService) StatsByPokedexID(pokedexIDs []int) (map[int]*model.PokemonStats, error) {
+	statsByPokemon := make(map[int]*model.PokemonStats, len(pokedexIDs))
+	if len(pokedexIDs) == 0 {
+		return statsByPokemon, nil
+	}
+
+	rows, err := s.db.Query(`
+		SELECT p.pokedex_id, ps.id, ps.pokemon_id, ps.hp, ps.attack, ps.defense,
+			ps.special_attack, ps.special_defense, ps.speed
+		FROM pokemon_stats ps
+		INNER JOIN pokemon p ON p.id = ps.pokemon_id
+		WHERE p.pokedex_id = ANY($1)
+	`, pq.Array(pokedexIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stats: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pokedexID int
+		var st model.PokemonStats
+		if err := rows.Scan(&pokedexID, &st.ID, &st.PokemonID, &st.HP, &st.Attack, &st.Defense,
+			&st.SpecialAttack, &st.SpecialDefense, &st.Speed); err != nil {
+			return nil, fmt.Errorf("failed to scan stats: %w", err)
+		}
+		statsByPokemon[pokedexID] = &st
+	}
+
+	return statsByPokemon, rows.Err()
+}
+
+// speciesByPokedexID batch-loads the species reference from each Pokemon's stored PokeAPI payload
+func (s *PokemonService) speciesByPokedexID(pokedexIDs []int) (map[int]json.RawMessage, error) {
+	speciesByPokemon := make(map[int]json.RawMessage, len(pokedexIDs))
+	if len(pokedexIDs) == 0 {
+		return speciesByPokemon, nil
+	}
+
+	rows, err := s.db.Query(`
+		SELECT p.pokedex_id, r.payload->'species'
+		FROM pokemon_raw r
+		INNER JOIN pokemon p ON p.id = r.pokemon_id
+		WHERE p.pokedex_id = ANY($1) AND r.payload ? 'species'
+	`, pq.Array(pokedexIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get species: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pokedexID int
+		var species []byte
+		if err := rows.Scan(&pokedexID, &species); err != nil {
+			return nil, fmt.Errorf("failed to scan species: %w", err)
+		}
+		speciesByPokemon[pokedexID] = json.RawMessage(species)
+	}
+
+	return speciesByPokemon, rows.Err()
+}
+
+// GetPokemonDetail retrieves a single Pokemon by its Pokedex ID, with types and any requested includes
+func (s *PokemonService) GetPokemonDetail(pokedexID int, include []string) (map[string]interface{}, error) {
+	cacheKey := fmt.Sprintf("detail|%d|%s", pokedexID, includeKey(include))
+	cached, generation, ok := s.reads.get(cacheKey)
+	if ok {
+		return cached.(map[string]interface{}), nil
+	}
+
+	rows, err := s.db.Query(`
+		SELECT `+pokemonListColumns+`, ''::text AS sort_key
+		FROM pokemon p
+		WHERE p.pokedex_id = $1
+	`, pokedexID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pokemon: %w", err)
+	}
+	page, _, err := scanPokemonList(rows, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(page) == 0 {
+		return nil, notFound("pokemon with pokedex id %d not found", pokedexID)
+	}
+
+	pokemons, err := s.expandPage(page, include)
+	if err != nil {
+		return nil, err
+	}
+
+	s.reads.put(cacheKey, pokemons[0], generation)
+	return pokemons[0], nil
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SortRelevance orders search results by how well the name matches the query
+const SortRelevance = "relevance"
+
+// likePrefix escapes LIKE wildcards in a search term and appends the prefix wildcard
+func likePrefix(q string) string {
+	escaper := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return escaper.Replace(q) + "%"
+}
+
+// searchCondition matches names by prefix or trigram similarity.
+// The prefix branch is served by idx_pokemon_name_pattern, the fuzzy branches by idx_pokemon_name_trgm.
+func searchCondition(prefixParam, queryParam string) string {
+	return fmt.Sprintf(`(
+		p.name LIKE %s
+		OR p.name %% %s
+		OR %s <%% p.name
+	)`, prefixParam, queryParam, queryParam)
+}
+
+// searchRankExpr scores a name against the query: exact match first, then prefix matches, then by similarity
+func searchRankExpr(prefixParam, queryParam string) string {
+	return fmt.Sprintf(`(
+		CASE WHEN p.name = %s THEN 2 WHEN p.name LIKE %s THEN 1 ELSE 0 END
+		+ GREATEST(similarity(p.name, %s), word_similarity(%s, p.name))
+	)`, queryParam, prefixParam, queryParam, queryParam)
+}
+
+// Autocomplete returns the top matching names for type-ahead, best match first
+func (s *PokemonService) Autocomplete(q string, limit int) ([]map[string]interface{}, error) {
+	if limit <= 0 || limit > 25 {
+		limit = 10 // Default
+	}
+
+	results := []map[string]interface{}{}
+	q = NormalizeName(q)
+	if q == "" {
+		return results, nil
+	}
+
+	rows, err := s.db.Query(`
+		SELECT p.pokedex_id, p.name, p.sprite_url
+		FROM pokemon p
+		WHERE `+searchCondition("$1", "$2")+`
+		ORDER BY `+searchRankExpr("$1", "$2")+` DESC, p.pokedex_id
+		LIMIT $3
+	`, likePrefix(q), q, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search pokemon: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pokedexID int
+		var name, spriteURL string
+		if err := rows.Scan(&pokedexID, &name, &spriteURL); err != nil {
+			return nil, fmt.Errorf("failed to scan pokemon: %w", err)
+		}
+		results = append(results, map[string]interface{}{
+			"id":         pokedexID,
+			"name":       name,
+			"sprite_url": spriteURL,
+		})
+	}
+
+	return results, rows.Err()
+}
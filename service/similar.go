@@ -0,0 +1,223 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/lib/pq"
+)
+
+// Distance metrics for SimilarPokemon
+const (
+	SimilarityCosine    = "cosine"
+	SimilarityEuclidean = "euclidean"
+)
+
+// Similar-Pokemon limits
+const (
+	defaultSimilarLimit = 6
+	maxSimilarLimit     = 50
+	// sharedStatTolerance is how close two normalized stats must be to count as shared
+	sharedStatTolerance = 0.1
+)
+
+// SimilarOptions tunes SimilarPokemon. Weights are relative to the stat similarity, which always counts 1.
+type SimilarOptions struct {
+	Metric        string  // SimilarityCosine (default) or SimilarityEuclidean
+	TypeWeight    float64 // weight of the share of types in common
+	AbilityWeight float64 // weight of the share of abilities in common
+}
+
+// statProfile is one Pokemon's base stats, types and abilities as loaded for similarity scoring
+type statProfile struct {
+	pokedexID int
+	name      string
+	spriteURL string
+	stats     []float64
+	types     []string
+	abilities []string
+}
+
+// SimilarPokemon returns the Pokemon nearest to pokedexID by base stat profile, each stat scaled by
+// the highest value stored so no stat dominates. Shared types and abilities add to the score when weighted.
+func (s *PokemonService) SimilarPokemon(pokedexID, limit int, opts SimilarOptions) ([]map[string]interface{}, error) {
+	if opts.Metric == "" {
+		opts.Metric = SimilarityCosine
+	}
+	if opts.Metric != SimilarityCosine && opts.Metric != SimilarityEuclidean {
+		return nil, invalidArgument("metric", "metric must be %q or %q", SimilarityCosine, SimilarityEuclidean)
+	}
+	if opts.TypeWeight < 0 || opts.AbilityWeight < 0 {
+		return nil, invalidArgument("weights", "weights can't be negative")
+	}
+	if limit <= 0 {
+		limit = defaultSimilarLimit
+	}
+	if limit > maxSimilarLimit {
+		limit = maxSimilarLimit
+	}
+
+	profiles, err := s.statProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	var target *statProfile
+	maxima := make([]float64, len(StatNames))
+	for i := range profiles {
+		if profiles[i].pokedexID == pokedexID {
+			target = &profiles[i]
+		}
+		for j, v := range profiles[i].stats {
+			maxima[j] = math.Max(maxima[j], v)
+		}
+	}
+	if target == nil {
+		return nil, notFound("stats for pokemon with id %d not found", pokedexID)
+	}
+	for i := range profiles {
+		for j := range profiles[i].stats {
+			if maxima[j] > 0 {
+				profiles[i].stats[j] /= maxima[j]
+			}
+		}
+	}
+
+	type scored struct {
+		profile    *statProfile
+		similarity float64
+	}
+	var results []scored
+	for i := range profiles {
+		candidate := &profiles[i]
+		if candidate.pokedexID == pokedexID {
+			continue
+		}
+
+		score := statSimilarity(opts.Metric, target.stats, candidate.stats)
+		score += opts.TypeWeight * overlap(target.types, candidate.types)
+		score += opts.AbilityWeight * overlap(target.abilities, candidate.abilities)
+		score /= 1 + opts.TypeWeight + opts.AbilityWeight
+
+		results = append(results, scored{candidate, score})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].similarity > results[j].similarity
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	similar := []map[string]interface{}{}
+	for _, result := range results {
+		candidate := result.profile
+
+		// Stats both have at a similar level, the target's strongest first
+		sharedStats := []string{}
+		order := make([]int, len(StatNames))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(a, b int) bool {
+			return target.stats[order[a]] > target.stats[order[b]]
+		})
+		for _, i := range order {
+			if math.Abs(target.stats[i]-candidate.stats[i]) <= sharedStatTolerance {
+				sharedStats = append(sharedStats, StatNames[i])
+			}
+		}
+
+		similar = append(similar, map[string]interface{}{
+			"id":               candidate.pokedexID,
+			"name":             candidate.name,
+			"sprite_url":       candidate.spriteURL,
+			"types":            candidate.types,
+			"similarity":       math.Round(result.similarity*1000) / 1000,
+			"shared_stats":     sharedStats,
+			"shared_types":     intersect(target.types, candidate.types),
+			"shared_abilities": intersect(target.abilities, candidate.abilities),
+		})
+	}
+
+	return similar, nil
+}
+
+// statProfiles loads every Pokemon with stored stats, with its types and abilities
+func (s *PokemonService) statProfiles() ([]statProfile, error) {
+	rows, err := s.db.Query(`
+		SELECT p.pokedex_id, p.name, p.sprite_url,
+			ps.hp, ps.attack, ps.defense, ps.special_attack, ps.special_defense, ps.speed,
+			ARRAY(SELECT pt.type_name::text FROM pokemon_types pt WHERE pt.pokemon_id = p.id ORDER BY pt.slot),
+			ARRAY(SELECT pa.ability_name::text FROM pokemon_abilities pa WHERE pa.pokemon_id = p.id ORDER BY pa.slot)
+		FROM pokemon p
+		INNER JOIN pokemon_stats ps ON ps.pokemon_id = p.id
+		ORDER BY p.pokedex_id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stat profiles: %w", err)
+	}
+	defer rows.Close()
+
+	var profiles []statProfile
+	for rows.Next() {
+		var p statProfile
+		var hp, attack, defense, specialAttack, specialDefense, speed int
+		var types, abilities pq.StringArray
+		if err := rows.Scan(&p.pokedexID, &p.name, &p.spriteURL,
+			&hp, &attack, &defense, &specialAttack, &specialDefense, &speed, &types, &abilities); err != nil {
+			return nil, fmt.Errorf("failed to scan stat profile: %w", err)
+		}
+		p.stats = []float64{float64(hp), float64(attack), float64(defense), float64(specialAttack), float64(specialDefense), float64(speed)}
+		p.types = []string(types)
+		p.abilities = []string(abilities)
+		profiles = append(profiles, p)
+	}
+
+	return profiles, rows.Err()
+}
+
+// statSimilarity scores two normalized stat vectors from 0 (unlike) to 1 (identical)
+func statSimilarity(metric string, a, b []float64) float64 {
+	if metric == SimilarityEuclidean {
+		sum := 0.0
+		for i := range a {
+			sum += (a[i] - b[i]) * (a[i] - b[i])
+		}
+		// Normalized stats lie in [0, 1], so the distance is at most sqrt(len)
+		return 1 - math.Sqrt(sum)/math.Sqrt(float64(len(a)))
+	}
+
+	dot, normA, normB := 0.0, 0.0, 0.0
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / math.Sqrt(normA*normB)
+}
+
+// overlap is the Jaccard index of two name lists: shared names over all distinct names
+func overlap(a, b []string) float64 {
+	shared := len(intersect(a, b))
+	union := len(a) + len(b) - shared
+	if union == 0 {
+		return 0
+	}
+	return float64(shared) / float64(union)
+}
+
+// intersect returns the names in both lists, in the order of the first
+func intersect(a, b []string) []string {
+	shared := []string{}
+	for _, name := range a {
+		if containsString(b, name) && !containsString(shared, name) {
+			shared = append(shared, name)
+		}
+	}
+	return shared
+}
@@ -0,0 +1,168 @@
+package service
+
+import "pokeAPI/model"
+
+// Stat names as used in requests and responses
+const (
+	StatHP             = "hp"
+	StatAttack         = "attack"
+	StatDefense        = "defense"
+	StatSpecialAttack  = "special_attack"
+	StatSpecialDefense = "special_defense"
+	StatSpeed          = "speed"
+)
+
+// StatNames lists the six stats in the order the games show them
+var StatNames = []string{StatHP, StatAttack, StatDefense, StatSpecialAttack, StatSpecialDefense, StatSpeed}
+
+// Limits of the Generation 5 stat formulas
+const (
+	MaxIV        = 31
+	MaxEV        = 255
+	MaxEVTotal   = 510
+	MaxLevel     = 100
+	defaultIV    = MaxIV
+	defaultLevel = MaxLevel
+)
+
+// StatSpread holds one value per stat, keyed by StatNames. Stats left out take a default.
+type StatSpread map[string]int
+
+// baseStat picks one stat out of a base stats row
+func baseStat(stats *model.PokemonStats, stat string) int {
+	switch stat {
+	case StatHP:
+		return stats.HP
+	case StatAttack:
+		return stats.Attack
+	case StatDefense:
+		return stats.Defense
+	case StatSpecialAttack:
+		return stats.SpecialAttack
+	case StatSpecialDefense:
+		return stats.SpecialDefense
+	case StatSpeed:
+		return stats.Speed
+	}
+	return 0
+}
+
+// calcStat applies the Generation 3+ stat formula. natureMod is in tenths (9, 10 or 11).
+func calcStat(stat string, base, iv, ev, level, natureMod int) int {
+	core := (2*base + iv + ev/4) * level / 100
+	if stat == StatHP {
+		return core + level + 10
+	}
+	return (core + 5) * natureMod / 10
+}
+
+// withDefaults fills in IVs (31) and EVs (0) for stats left out of a spread
+func withDefaults(ivs, evs StatSpread) (StatSpread, StatSpread) {
+	filledIVs, filledEVs := StatSpread{}, StatSpread{}
+	for _, stat := range StatNames {
+		iv, ok := ivs[stat]
+		if !ok {
+			iv = defaultIV
+		}
+		filledIVs[stat] = iv
+		filledEVs[stat] = evs[stat]
+	}
+	return filledIVs, filledEVs
+}
+
+// validateLevel checks a level is 1-100
+func validateLevel(level int) error {
+	if level < 1 || level > MaxLevel {
+		return invalidArgument("level", "level must be between 1 and %d", MaxLevel)
+	}
+	return nil
+}
+
+// validateSpread checks stat names, IV and EV ranges and the EV total
+func validateSpread(ivs, evs StatSpread) error {
+	for stat, iv := range ivs {
+		if !containsString(StatNames, stat) {
+			return invalidArgument("ivs", "unknown stat %q in ivs", stat)
+		}
+		if iv < 0 || iv > MaxIV {
+			return invalidArgument("ivs."+stat, "ivs.%s must be between 0 and %d", stat, MaxIV)
+		}
+	}
+
+	total := 0
+	for stat, ev := range evs {
+		if !containsString(StatNames, stat) {
+			return invalidArgument("evs", "unknown stat %q in evs", stat)
+		}
+		if ev < 0 || ev > MaxEV {
+			return invalidArgument("evs."+stat, "evs.%s must be between 0 and %d", stat, MaxEV)
+		}
+		total += ev
+	}
+	if total > MaxEVTotal {
+		return invalidArgument("evs", "evs add up to %d, more than %d", total, MaxEVTotal)
+	}
+	return nil
+}
+
+// actualStats computes all six stats at a level; IVs and EVs left out default to 31 and 0
+func actualStats(base *model.PokemonStats, level int, nature Nature, ivs, evs StatSpread) StatSpread {
+	ivs, evs = withDefaults(ivs, evs)
+	stats := make(StatSpread, len(StatNames))
+	for _, stat := range StatNames {
+		stats[stat] = calcStat(stat, baseStat(base, stat), ivs[stat], evs[stat], level, nature.modifier(stat))
+	}
+	return stats
+}
+
+// CalculateStats returns a Pokemon's exact stats for the given level, nature, IVs and EVs,
+// along with the lowest and highest each stat can be at that level
+func (s *PokemonService) CalculateStats(pokedexID, level int, natureName string, ivs, evs StatSpread) (map[string]interface{}, error) {
+	if level == 0 {
+		level = defaultLevel
+	}
+	if err := validateLevel(level); err != nil {
+		return nil, err
+	}
+	if err := validateSpread(ivs, evs); err != nil {
+		return nil, err
+	}
+	nature, err := s.lookupNature(natureName)
+	if err != nil {
+		return nil, err
+	}
+
+	statsByPokemon, err := s.StatsByPokedexID([]int{pokedexID})
+	if err != nil {
+		return nil, err
+	}
+	base := statsByPokemon[pokedexID]
+	if base == nil {
+		return nil, notFound("stats for pokemon with id %d not found", pokedexID)
+	}
+
+	// Ranges run from 0 IVs, 0 EVs and a hindering nature to 31 IVs, 252 EVs and a helpful one
+	ranges := make(map[string]interface{}, len(StatNames))
+	for _, stat := range StatNames {
+		low, high := 9, 11
+		if stat == StatHP {
+			low, high = 10, 10
+		}
+		ranges[stat] = map[string]int{
+			"min": calcStat(stat, baseStat(base, stat), 0, 0, level, low),
+			"max": calcStat(stat, baseStat(base, stat), MaxIV, 252, level, high),
+		}
+	}
+
+	filledIVs, filledEVs := withDefaults(ivs, evs)
+	return map[string]interface{}{
+		"id":     pokedexID,
+		"level":  level,
+		"nature": nature,
+		"ivs":    filledIVs,
+		"evs":    filledEVs,
+		"base":   statsResponse(base),
+		"stats":  actualStats(base, level, nature, ivs, evs),
+		"ranges": ranges,
+	}, nil
+}
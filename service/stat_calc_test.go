@@ -0,0 +1,30 @@
+package service
+
+import "testing"
+
+// Expected values are the published level 50 and 100 stats for each spread
+func TestCalcStat(t *testing.T) {
+	tests := []struct {
+		name      string
+		stat      string
+		base      int
+		iv, ev    int
+		level     int
+		natureMod int
+		want      int
+	}{
+		{"garchomp max hp", StatHP, 108, 31, 252, 100, 10, 420},
+		{"blissey max hp", StatHP, 255, 31, 252, 100, 10, 714},
+		{"garchomp adamant max attack", StatAttack, 130, 31, 252, 100, 11, 394},
+		{"garchomp brave min speed", StatSpeed, 102, 0, 0, 100, 9, 188},
+		{"garchomp jolly max speed at 50", StatSpeed, 102, 31, 252, 50, 11, 169},
+		{"garchomp max hp at 50", StatHP, 108, 31, 252, 50, 10, 215},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calcStat(tt.stat, tt.base, tt.iv, tt.ev, tt.level, tt.natureMod); got != tt.want {
+				t.Errorf("calcStat() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,289 @@
+package service
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// summaryStats are the stats aggregated by GetStatsSummary, as columns of the filtered set
+var summaryStats = []string{StatHP, StatAttack, StatDefense, StatSpecialAttack, StatSpecialDefense, StatSpeed, "base_stat_total"}
+
+// Summary shape limits
+const (
+	summaryTopAbilities     = 10
+	summaryHistogramBuckets = 10
+	maxCachedSummaries      = 64
+)
+
+// summaryCache keeps computed summaries per filter until the data changes
+type summaryCache struct {
+	mu      sync.Mutex
+	entries map[string]map[string]interface{}
+}
+
+func newSummaryCache() *summaryCache {
+	return &summaryCache{entries: map[string]map[string]interface{}{}}
+}
+
+func (c *summaryCache) get(key string) (map[string]interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	summary, ok := c.entries[key]
+	return summary, ok
+}
+
+func (c *summaryCache) put(key string, summary map[string]interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Filters are user input, so start over rather than grow without bound
+	if len(c.entries) >= maxCachedSummaries {
+		c.entries = map[string]map[string]interface{}{}
+	}
+	c.entries[key] = summary
+}
+
+// invalidate drops every cached summary; called whenever a Pokemon is saved
+func (c *summaryCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = map[string]map[string]interface{}{}
+}
+
+// GetStatsSummary aggregates the Pokemon matching the filter: counts per type and type combination,
+// base stat averages, medians and maxima overall and per type, height and weight distributions and
+// the most common abilities. Results are cached until the next save.
+func (s *PokemonService) GetStatsSummary(filter PokemonFilter) (map[string]interface{}, error) {
+	key := filter.cacheKey()
+	if summary, ok := s.summaries.get(key); ok {
+		return summary, nil
+	}
+
+	where, args := filter.whereClause(nil)
+	filtered := `WITH filtered AS (
+		SELECT p.id, p.height, p.weight, ps.hp, ps.attack, ps.defense, ps.special_attack,
+			ps.special_defense, ps.speed, ` + baseStatTotalExpr + ` AS base_stat_total
+	` + pokemonFromClause + where + `
+	)`
+
+	var total int
+	if err := s.db.QueryRow(filtered+` SELECT COUNT(*) FROM filtered`, args...).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to count pokemon: %w", err)
+	}
+
+	typeCounts, err := s.countRows(filtered+`
+		SELECT pt.type_name, COUNT(*)
+		FROM filtered f
+		INNER JOIN pokemon_types pt ON pt.pokemon_id = f.id
+		GROUP BY pt.type_name
+		ORDER BY COUNT(*) DESC, pt.type_name
+	`, args)
+	if err != nil {
+		return nil, err
+	}
+
+	comboCounts, err := s.countRows(filtered+`
+		SELECT combo, COUNT(*)
+		FROM (
+			SELECT string_agg(pt.type_name, '/' ORDER BY pt.slot) AS combo
+			FROM filtered f
+			INNER JOIN pokemon_types pt ON pt.pokemon_id = f.id
+			GROUP BY f.id
+		) c
+		GROUP BY combo
+		ORDER BY COUNT(*) DESC, combo
+	`, args)
+	if err != nil {
+		return nil, err
+	}
+
+	overall, err := s.statAggregates(filtered, "", args)
+	if err != nil {
+		return nil, err
+	}
+	byType, err := s.statAggregates(filtered, "pt.type_name", args)
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := s.distribution(filtered, "height", args)
+	if err != nil {
+		return nil, err
+	}
+	weight, err := s.distribution(filtered, "weight", args)
+	if err != nil {
+		return nil, err
+	}
+
+	abilities, err := s.countRows(filtered+fmt.Sprintf(`
+		SELECT pa.ability_name, COUNT(DISTINCT f.id)
+		FROM filtered f
+		INNER JOIN pokemon_abilities pa ON pa.pokemon_id = f.id
+		GROUP BY pa.ability_name
+		ORDER BY COUNT(DISTINCT f.id) DESC, pa.ability_name
+		LIMIT %d
+	`, summaryTopAbilities), args)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := map[string]interface{}{
+		"total":             total,
+		"types":             typeCounts,
+		"type_combinations": comboCounts,
+		"stats":             overall[""],
+		"stats_by_type":     byType,
+		"height":            height,
+		"weight":            weight,
+		"top_abilities":     abilities,
+	}
+	s.summaries.put(key, summary)
+	return summary, nil
+}
+
+// countRows runs a query returning (name, count) rows
+func (s *PokemonService) countRows(query string, args []interface{}) ([]map[string]interface{}, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query summary counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := []map[string]interface{}{}
+	for rows.Next() {
+		var name string
+		var count int
+		if err := rows.Scan(&name, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan summary count: %w", err)
+		}
+		counts = append(counts, map[string]interface{}{"name": name, "count": count})
+	}
+
+	return counts, rows.Err()
+}
+
+// statAggregates computes the average, median and maximum of every summary stat, grouped by groupExpr
+// (joined against pokemon_types) or over the whole set when groupExpr is empty
+func (s *PokemonService) statAggregates(filtered, groupExpr string, args []interface{}) (map[string]interface{}, error) {
+	var columns []string
+	for _, stat := range summaryStats {
+		columns = append(columns,
+			fmt.Sprintf("ROUND(AVG(f.%s)::numeric, 1)::float8", stat),
+			fmt.Sprintf("percentile_cont(0.5) WITHIN GROUP (ORDER BY f.%s)", stat),
+			fmt.Sprintf("MAX(f.%s)::float8", stat),
+		)
+	}
+
+	query := filtered + ` SELECT ''::text, ` + strings.Join(columns, ", ") + ` FROM filtered f`
+	if groupExpr != "" {
+		query = filtered + ` SELECT ` + groupExpr + `, ` + strings.Join(columns, ", ") + `
+			FROM filtered f
+			INNER JOIN pokemon_types pt ON pt.pokemon_id = f.id
+			GROUP BY ` + groupExpr + `
+			ORDER BY ` + groupExpr
+	}
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stat aggregates: %w", err)
+	}
+	defer rows.Close()
+
+	result := map[string]interface{}{}
+	for rows.Next() {
+		var group string
+		values := make([]sql.NullFloat64, len(columns))
+		dest := []interface{}{&group}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to scan stat aggregates: %w", err)
+		}
+
+		stats := make(map[string]interface{}, len(summaryStats))
+		for i, stat := range summaryStats {
+			stats[stat] = map[string]interface{}{
+				"average": nullableFloat(values[i*3]),
+				"median":  nullableFloat(values[i*3+1]),
+				"max":     nullableFloat(values[i*3+2]),
+			}
+		}
+		result[group] = stats
+	}
+
+	return result, rows.Err()
+}
+
+// distribution returns quartiles and an equal-width histogram of a pokemon column
+func (s *PokemonService) distribution(filtered, column string, args []interface{}) (map[string]interface{}, error) {
+	var min, q1, median, q3, max, avg sql.NullFloat64
+	err := s.db.QueryRow(filtered+fmt.Sprintf(`
+		SELECT MIN(f.%[1]s)::float8,
+			percentile_cont(0.25) WITHIN GROUP (ORDER BY f.%[1]s),
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY f.%[1]s),
+			percentile_cont(0.75) WITHIN GROUP (ORDER BY f.%[1]s),
+			MAX(f.%[1]s)::float8,
+			ROUND(AVG(f.%[1]s)::numeric, 1)::float8
+		FROM filtered f
+	`, column), args...).Scan(&min, &q1, &median, &q3, &max, &avg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s distribution: %w", column, err)
+	}
+
+	histogram := []map[string]interface{}{}
+	if min.Valid {
+		// Buckets cover [min, max] in equal integer-friendly widths; the last one includes max
+		width := (max.Float64 - min.Float64 + 1) / summaryHistogramBuckets
+		counts := make([]int, summaryHistogramBuckets)
+
+		rows, err := s.db.Query(filtered+fmt.Sprintf(`
+			SELECT width_bucket(f.%s, $%d, $%d, %d), COUNT(*)
+			FROM filtered f
+			GROUP BY 1
+		`, column, len(args)+1, len(args)+2, summaryHistogramBuckets), append(args, min.Float64, max.Float64+1)...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query %s histogram: %w", column, err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var bucket, count int
+			if err := rows.Scan(&bucket, &count); err != nil {
+				return nil, fmt.Errorf("failed to scan %s histogram: %w", column, err)
+			}
+			if bucket >= 1 && bucket <= summaryHistogramBuckets {
+				counts[bucket-1] = count
+			}
+		}
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read %s histogram: %w", column, err)
+		}
+
+		for i, count := range counts {
+			histogram = append(histogram, map[string]interface{}{
+				"from":  min.Float64 + float64(i)*width,
+				"to":    min.Float64 + float64(i+1)*width,
+				"count": count,
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"min":       nullableFloat(min),
+		"q1":        nullableFloat(q1),
+		"median":    nullableFloat(median),
+		"q3":        nullableFloat(q3),
+		"max":       nullableFloat(max),
+		"average":   nullableFloat(avg),
+		"histogram": histogram,
+	}, nil
+}
+
+// nullableFloat turns a NULL aggregate (empty set) into a JSON null
+func nullableFloat(v sql.NullFloat64) interface{} {
+	if !v.Valid {
+		return nil
+	}
+	return v.Float64
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// Sync job states
+const (
+	SyncJobRunning   = "running"
+	SyncJobCompleted = "completed"
+	SyncJobFailed    = "failed"
+)
+
+// maxFinishedSyncJobs bounds how many finished jobs are kept around for lookups
+const maxFinishedSyncJobs = 20
+
+// SyncJob is a snapshot of a background sync's progress
+type SyncJob struct {
+	ID         string     `json:"id"`
+	Status     string     `json:"status"`
+	Total      int        `json:"total"`
+	Processed  int        `json:"processed"`
+	Saved      int        `json:"saved"`
+	Failed     int        `json:"failed"`
+	Current    string     `json:"current"` // name of the Pokemon saved last
+	StartedAt  time.Time  `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+	Error      string     `json:"error,omitempty"`
+}
+
+// Finished reports whether the job has stopped, successfully or not
+func (j SyncJob) Finished() bool {
+	return j.Status != SyncJobRunning
+}
+
+// syncJob is a running or finished job; updated is closed and replaced on every change to wake watchers
+type syncJob struct {
+	mu      sync.Mutex
+	state   SyncJob
+	updated chan struct{}
+}
+
+// update applies a change to the job and wakes its watchers. Safe to call on a nil job.
+func (j *syncJob) update(change func(*SyncJob)) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	change(&j.state)
+	close(j.updated)
+	j.updated = make(chan struct{})
+}
+
+func (j *syncJob) snapshot() (SyncJob, <-chan struct{}) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.state, j.updated
+}
+
+// syncJobTracker keeps recent jobs and makes sure only one sync runs at a time
+type syncJobTracker struct {
+	mu      sync.Mutex
+	jobs    map[string]*syncJob
+	order   []string // job IDs, oldest first
+	running *syncJob
+}
+
+func newSyncJobTracker() *syncJobTracker {
+	return &syncJobTracker{jobs: map[string]*syncJob{}}
+}
+
+// StartSyncJob starts a Gen 5 sync in the background and returns its first snapshot.
+// If a sync is already running, that job is returned along with an ErrConflict error.
+func (s *PokemonService) StartSyncJob() (SyncJob, error) {
+	t := s.syncJobs
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.running != nil {
+		if snapshot, _ := t.running.snapshot(); !snapshot.Finished() {
+			return snapshot, conflict("a Gen 5 Pokemon sync is already running (job %s)", snapshot.ID)
+		}
+	}
+
+	start, end := GetGen5Range()
+	j := &syncJob{
+		state: SyncJob{
+			ID:        newSyncJobID(),
+			Status:    SyncJobRunning,
+			Total:     end - start + 1,
+			StartedAt: time.Now(),
+		},
+		updated: make(chan struct{}),
+	}
+	t.jobs[j.state.ID] = j
+	t.order = append(t.order, j.state.ID)
+	t.running = j
+	t.prune()
+
+	go func() {
+		err := s.syncGen5(j)
+		j.update(func(state *SyncJob) {
+			now := time.Now()
+			state.FinishedAt = &now
+			state.Status = SyncJobCompleted
+			if err != nil {
+				state.Status = SyncJobFailed
+				state.Error = err.Error()
+			}
+		})
+	}()
+
+	snapshot, _ := j.snapshot()
+	return snapshot, nil
+}
+
+// GetSyncJob returns the latest snapshot of a sync job
+func (s *PokemonService) GetSyncJob(id string) (SyncJob, error) {
+	j := s.syncJobs.get(id)
+	if j == nil {
+		return SyncJob{}, notFound("sync job %q not found", id)
+	}
+	snapshot, _ := j.snapshot()
+	return snapshot, nil
+}
+
+// WatchSyncJob calls send with the job's current state and again after every change,
+// returning once the job has finished, send fails, or ctx is cancelled
+func (s *PokemonService) WatchSyncJob(ctx context.Context, id string, send func(SyncJob) error) error {
+	j := s.syncJobs.get(id)
+	if j == nil {
+		return notFound("sync job %q not found", id)
+	}
+
+	for {
+		snapshot, updated := j.snapshot()
+		if err := send(snapshot); err != nil {
+			return err
+		}
+		if snapshot.Finished() {
+			return nil
+		}
+
+		select {
+		case <-updated:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (t *syncJobTracker) get(id string) *syncJob {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.jobs[id]
+}
+
+// prune drops the oldest finished jobs beyond maxFinishedSyncJobs. Callers hold t.mu.
+func (t *syncJobTracker) prune() {
+	for len(t.order) > maxFinishedSyncJobs+1 {
+		oldest := t.order[0]
+		t.order = t.order[1:]
+		delete(t.jobs, oldest)
+	}
+}
+
+func newSyncJobID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
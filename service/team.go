@@ -0,0 +1,245 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// MaxTeamSize is the most Pokemon a team may hold
+const MaxTeamSize = 6
+
+// maxTeamSuggestions bounds how many Pokemon a team analysis suggests
+const maxTeamSuggestions = 5
+
+// AnalyzeTeam works out a team's defensive type profile and STAB offensive coverage, and suggests
+// Pokemon that resist its uncovered weaknesses. It also returns the inputs that matched nothing.
+func (s *PokemonService) AnalyzeTeam(idsOrNames []string) (map[string]interface{}, []string, error) {
+	team, missing, err := s.GetPokemonBatch(idsOrNames, nil)
+	if err != nil || len(missing) > 0 {
+		return nil, missing, err
+	}
+
+	ids := make([]int, len(team))
+	typesOf := make([][]string, len(team))
+	for i, pokemon := range team {
+		ids[i], _ = pokemon["id"].(int)
+		typesOf[i], _ = pokemon["types"].([]string)
+	}
+	abilitiesByPokemon, err := s.AbilitiesByPokedexID(ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	absorbsOf := make([]map[string]bool, len(team))
+	for i, id := range ids {
+		var names []string
+		for _, ability := range abilitiesByPokemon[id] {
+			names = append(names, ability.AbilityName)
+		}
+		absorbsOf[i] = alwaysAbsorbed(names)
+	}
+
+	defense := []map[string]interface{}{}
+	sharedWeaknesses := []string{}
+	var uncovered []string
+	weakCount := map[string]int{}
+	for _, attacking := range PokemonTypes {
+		members := make([]map[string]interface{}, len(team))
+		weak, resist, immune := []int{}, []int{}, []int{}
+		for i := range team {
+			multiplier := TypeEffectiveness(attacking, typesOf[i])
+			if absorbsOf[i][attacking] {
+				multiplier = 0
+			}
+			members[i] = map[string]interface{}{"id": ids[i], "multiplier": multiplier}
+			switch {
+			case multiplier == 0:
+				immune = append(immune, ids[i])
+			case multiplier < 1:
+				resist = append(resist, ids[i])
+			case multiplier > 1:
+				weak = append(weak, ids[i])
+			}
+		}
+
+		defense = append(defense, map[string]interface{}{
+			"type":    attacking,
+			"members": members,
+			"weak":    weak,
+			"resist":  resist,
+			"immune":  immune,
+		})
+
+		weakCount[attacking] = len(weak)
+		if len(weak) >= 2 {
+			sharedWeaknesses = append(sharedWeaknesses, attacking)
+		}
+		if len(weak) > 0 && len(resist) == 0 && len(immune) == 0 {
+			uncovered = append(uncovered, attacking)
+		}
+	}
+
+	// Biggest holes first: the most members hit super effectively
+	sort.SliceStable(uncovered, func(i, j int) bool {
+		return weakCount[uncovered[i]] > weakCount[uncovered[j]]
+	})
+
+	offense := []map[string]interface{}{}
+	superEffective, notCovered := []string{}, []string{}
+	for _, defending := range PokemonTypes {
+		best, by := 0.0, []int{}
+		for i := range team {
+			_, multiplier := bestSTABEffectiveness(typesOf[i], []string{defending})
+			switch {
+			case multiplier > best:
+				best, by = multiplier, []int{ids[i]}
+			case multiplier == best:
+				by = append(by, ids[i])
+			}
+		}
+
+		offense = append(offense, map[string]interface{}{
+			"type":       defending,
+			"multiplier": best,
+			"by":         by,
+		})
+		if best > 1 {
+			superEffective = append(superEffective, defending)
+		} else {
+			notCovered = append(notCovered, defending)
+		}
+	}
+
+	suggestions, err := s.suggestTeamPatches(ids, uncovered)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if uncovered == nil {
+		uncovered = []string{}
+	}
+	return map[string]interface{}{
+		"team":                 team,
+		"defense":              defense,
+		"shared_weaknesses":    sharedWeaknesses,
+		"uncovered_weaknesses": uncovered,
+		"offense": map[string]interface{}{
+			"types":           offense,
+			"super_effective": superEffective,
+			"not_covered":     notCovered,
+		},
+		"suggestions": suggestions,
+	}, missing, nil
+}
+
+// alwaysAbsorbed returns the types a Pokemon is immune to through its ability whichever ability it has:
+// those every one of its possible abilities absorbs. Eelektross, which only gets Levitate, is immune to
+// ground; Lanturn, which may have Volt Absorb, Water Absorb or Illuminate, is immune to nothing.
+func alwaysAbsorbed(abilityNames []string) map[string]bool {
+	absorbed := map[string]bool{}
+	for i, name := range abilityNames {
+		absorbedType, ok := typeImmunityAbilities[NormalizeName(name)]
+		if !ok || (i > 0 && !absorbed[absorbedType]) {
+			return map[string]bool{}
+		}
+		absorbed[absorbedType] = true
+	}
+	return absorbed
+}
+
+// suggestTeamPatches finds Pokemon outside the team that patch a hole, by resisting its type or always
+// having an ability that absorbs it (see alwaysAbsorbed), ranked by how many holes they patch and then by
+// base stat total. Candidates are narrowed in SQL to Pokemon with a resisting type or an absorbing ability.
+func (s *PokemonService) suggestTeamPatches(teamIDs []int, holes []string) ([]map[string]interface{}, error) {
+	suggestions := []map[string]interface{}{}
+	if len(holes) == 0 {
+		return suggestions, nil
+	}
+
+	// Only a type that resists some hole can bring a typing's multiplier for it below 1
+	var resistingTypes []string
+	for _, defending := range PokemonTypes {
+		for _, hole := range holes {
+			if TypeEffectiveness(hole, []string{defending}) < 1 {
+				resistingTypes = append(resistingTypes, defending)
+				break
+			}
+		}
+	}
+	var absorbingAbilities []string
+	for ability, absorbed := range typeImmunityAbilities {
+		if containsString(holes, absorbed) {
+			absorbingAbilities = append(absorbingAbilities, ability)
+		}
+	}
+	sort.Strings(absorbingAbilities)
+
+	filter := PokemonFilter{Types: resistingTypes, TypeMatch: TypeMatchAny}
+	conds, args := filter.conditions(nil)
+	if len(absorbingAbilities) > 0 {
+		args = append(args, pq.Array(absorbingAbilities))
+		conds = []string{fmt.Sprintf(`(%s OR EXISTS (
+			SELECT 1 FROM pokemon_abilities pa
+			WHERE pa.pokemon_id = p.id AND pa.ability_name = ANY($%d)
+		))`, strings.Join(conds, " AND "), len(args))}
+	}
+	args = append(args, pq.Array(teamIDs))
+	conds = append(conds, fmt.Sprintf("p.pokedex_id <> ALL($%d)", len(args)))
+
+	rows, err := s.db.Query(`
+		SELECT `+pokemonListColumns+`, ''::text AS sort_key
+	`+pokemonFromClause+`
+		WHERE `+strings.Join(conds, " AND ")+`
+		ORDER BY `+sortColumns["base_stat_total"]+` DESC, p.pokedex_id
+	`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query suggestions: %w", err)
+	}
+	page, _, err := scanPokemonList(rows, false)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := s.expandPage(page, []string{IncludeStats, IncludeAbilities})
+	if err != nil {
+		return nil, err
+	}
+
+	type scored struct {
+		pokemon map[string]interface{}
+		patches []string
+	}
+	var ranked []scored
+	for _, candidate := range candidates {
+		types, _ := candidate["types"].([]string)
+		var abilityNames []string
+		abilities, _ := candidate[IncludeAbilities].([]map[string]interface{})
+		for _, ability := range abilities {
+			name, _ := ability["name"].(string)
+			abilityNames = append(abilityNames, name)
+		}
+		absorbed := alwaysAbsorbed(abilityNames)
+
+		var patches []string
+		for _, hole := range holes {
+			if TypeEffectiveness(hole, types) < 1 || absorbed[hole] {
+				patches = append(patches, hole)
+			}
+		}
+		if len(patches) > 0 {
+			ranked = append(ranked, scored{candidate, patches})
+		}
+	}
+
+	// Stable, so ties keep the base stat total order from the query
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return len(ranked[i].patches) > len(ranked[j].patches)
+	})
+	for i := 0; i < len(ranked) && i < maxTeamSuggestions; i++ {
+		ranked[i].pokemon["patches"] = ranked[i].patches
+		suggestions = append(suggestions, ranked[i].pokemon)
+	}
+
+	return suggestions, nil
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAlwaysAbsorbed(t *testing.T) {
+	tests := []struct {
+		name      string
+		abilities []string
+		want      map[string]bool
+	}{
+		{"eelektross", []string{"levitate"}, map[string]bool{"ground": true}},
+		{"lanturn", []string{"volt-absorb", "illuminate", "water-absorb"}, map[string]bool{}},
+		{"mantine", []string{"swift-swim", "water-absorb", "water-veil"}, map[string]bool{}},
+		{"two water abilities", []string{"water-absorb", "storm-drain"}, map[string]bool{"water": true}},
+		{"volt and water absorb", []string{"volt-absorb", "water-absorb"}, map[string]bool{}},
+		{"no abilities", nil, map[string]bool{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := alwaysAbsorbed(tt.abilities); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("alwaysAbsorbed(%v) = %v, want %v", tt.abilities, got, tt.want)
+			}
+		})
+	}
+}
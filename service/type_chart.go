@@ -0,0 +1,57 @@
+package service
+
+// PokemonTypes lists the seventeen Generation 5 types in Pokedex order
+var PokemonTypes = []string{
+	"normal", "fire", "water", "electric", "grass", "ice", "fighting", "poison", "ground",
+	"flying", "psychic", "bug", "rock", "ghost", "dragon", "dark", "steel",
+}
+
+// typeChart holds the Generation 5 type chart; pairs left out are neutral (1x)
+var typeChart = map[string]map[string]float64{
+	"normal":   {"rock": 0.5, "ghost": 0, "steel": 0.5},
+	"fire":     {"fire": 0.5, "water": 0.5, "grass": 2, "ice": 2, "bug": 2, "rock": 0.5, "dragon": 0.5, "steel": 2},
+	"water":    {"fire": 2, "water": 0.5, "grass": 0.5, "ground": 2, "rock": 2, "dragon": 0.5},
+	"electric": {"water": 2, "electric": 0.5, "grass": 0.5, "ground": 0, "flying": 2, "dragon": 0.5},
+	"grass":    {"fire": 0.5, "water": 2, "grass": 0.5, "poison": 0.5, "ground": 2, "flying": 0.5, "bug": 0.5, "rock": 2, "dragon": 0.5, "steel": 0.5},
+	"ice":      {"fire": 0.5, "water": 0.5, "grass": 2, "ice": 0.5, "ground": 2, "flying": 2, "dragon": 2, "steel": 0.5},
+	"fighting": {"normal": 2, "ice": 2, "poison": 0.5, "flying": 0.5, "psychic": 0.5, "bug": 0.5, "rock": 2, "ghost": 0, "dark": 2, "steel": 2},
+	"poison":   {"grass": 2, "poison": 0.5, "ground": 0.5, "rock": 0.5, "ghost": 0.5, "steel": 0},
+	"ground":   {"fire": 2, "electric": 2, "grass": 0.5, "poison": 2, "flying": 0, "bug": 0.5, "rock": 2, "steel": 2},
+	"flying":   {"electric": 0.5, "grass": 2, "fighting": 2, "bug": 2, "rock": 0.5, "steel": 0.5},
+	"psychic":  {"fighting": 2, "poison": 2, "psychic": 0.5, "dark": 0, "steel": 0.5},
+	"bug":      {"fire": 0.5, "grass": 2, "fighting": 0.5, "poison": 0.5, "flying": 0.5, "psychic": 2, "ghost": 0.5, "dark": 2, "steel": 0.5},
+	"rock":     {"fire": 2, "ice": 2, "fighting": 0.5, "ground": 0.5, "flying": 2, "bug": 2, "steel": 0.5},
+	"ghost":    {"normal": 0, "psychic": 2, "ghost": 2, "dark": 0.5, "steel": 0.5},
+	"dragon":   {"dragon": 2, "steel": 0.5},
+	"dark":     {"fighting": 0.5, "psychic": 2, "ghost": 2, "dark": 0.5, "steel": 0.5},
+	"steel":    {"fire": 0.5, "water": 0.5, "electric": 0.5, "ice": 2, "rock": 2, "steel": 0.5},
+}
+
+// IsPokemonType reports whether name is one of the Generation 5 types
+func IsPokemonType(name string) bool {
+	_, ok := typeChart[name]
+	return ok
+}
+
+// TypeEffectiveness returns the multiplier of an attacking type against a defender's types.
+// Types missing from the chart (e.g. fairy, which Generation 5 lacks) count as neutral.
+func TypeEffectiveness(attacking string, defending []string) float64 {
+	multiplier := 1.0
+	for _, t := range defending {
+		if m, ok := typeChart[attacking][t]; ok {
+			multiplier *= m
+		}
+	}
+	return multiplier
+}
+
+// bestSTABEffectiveness returns the best multiplier any of the attacker's own types gets against the defender
+func bestSTABEffectiveness(attacking, defending []string) (string, float64) {
+	bestType, best := "", 0.0
+	for _, t := range attacking {
+		if m := TypeEffectiveness(t, defending); bestType == "" || m > best {
+			bestType, best = t, m
+		}
+	}
+	return bestType, best
+}
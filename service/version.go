@@ -0,0 +1,78 @@
+package service
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// versionCache keeps the data version until the data changes, so conditional requests that end in a
+// 304 don't query Postgres. Like readCache it counts invalidations, so a version read before one can't
+// be stored after it.
+type versionCache struct {
+	mu           sync.Mutex
+	valid        bool
+	version      string
+	lastModified time.Time
+	generation   uint64
+}
+
+func newVersionCache() *versionCache {
+	return &versionCache{}
+}
+
+// get returns the cached version, or on a miss the generation to pass to put
+func (c *versionCache) get() (string, time.Time, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.version, c.lastModified, c.generation, c.valid
+}
+
+func (c *versionCache) put(version string, lastModified time.Time, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	c.version, c.lastModified, c.valid = version, lastModified, true
+}
+
+// invalidate drops the cached version; called with the read cache purge on every save, sync or
+// invalidation from another replica
+func (c *versionCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.valid = false
+	c.generation++
+}
+
+// DataVersion identifies the current state of the stored Pokemon: it changes whenever a Pokemon is
+// saved or removed, or a sync completes. lastModified is the newest of those times, in UTC. The result
+// is cached until the next invalidation.
+func (s *PokemonService) DataVersion() (version string, lastModified time.Time, err error) {
+	version, lastModified, generation, ok := s.versions.get()
+	if ok {
+		return version, lastModified, nil
+	}
+
+	var count int
+	var updatedAt, syncedAt time.Time
+	err = s.db.QueryRow(`
+		SELECT COUNT(*),
+			COALESCE(MAX(updated_at), 'epoch'),
+			COALESCE((SELECT MAX(last_sync_at) FROM sync_metadata), 'epoch')
+		FROM pokemon
+	`).Scan(&count, &updatedAt, &syncedAt)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to query data version: %w", err)
+	}
+
+	lastModified = updatedAt
+	if syncedAt.After(lastModified) {
+		lastModified = syncedAt
+	}
+	version = fmt.Sprintf("%d-%d-%d", count, updatedAt.UnixNano(), syncedAt.UnixNano())
+	lastModified = lastModified.UTC()
+	s.versions.put(version, lastModified, generation)
+	return version, lastModified, nil
+}